@@ -22,6 +22,30 @@ type Branch struct {
 	WebURL             string `json:"web_url"`
 }
 
+// ProtectedBranch represents a protected branch of a project, as found on
+// project approval rules.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/protected_branches.html
+type ProtectedBranch struct {
+	ID                        int                        `json:"id"`
+	Name                      string                     `json:"name"`
+	PushAccessLevels          []*BranchAccessDescription `json:"push_access_levels"`
+	MergeAccessLevels         []*BranchAccessDescription `json:"merge_access_levels"`
+	UnprotectAccessLevels     []*BranchAccessDescription `json:"unprotect_access_levels"`
+	AllowForcePush            bool                       `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool                       `json:"code_owner_approval_required"`
+}
+
+// BranchAccessDescription represents who may push to, merge into or
+// unprotect a protected branch: a role, a user or a group.
+type BranchAccessDescription struct {
+	ID                     int              `json:"id"`
+	AccessLevel            AccessLevelValue `json:"access_level"`
+	AccessLevelDescription string           `json:"access_level_description"`
+	UserID                 int              `json:"user_id"`
+	GroupID                int              `json:"group_id"`
+}
+
 // ListBranchesOptions represents the available ListBranches() options.
 //
 // GitLab API docs:
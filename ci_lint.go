@@ -0,0 +1,186 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nexuer/utils/ptr"
+)
+
+// DefaultCIConfigPath is the default path of the CI/CD configuration file.
+const DefaultCIConfigPath = ".gitlab-ci.yml"
+
+// CILintService handles communication with the CI lint related methods of
+// the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/lint.html
+type CILintService service
+
+// ProjectLintResult represents the result of linting a CI/CD configuration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/lint.html
+type ProjectLintResult struct {
+	Valid      bool           `json:"valid"`
+	Errors     []string       `json:"errors"`
+	Warnings   []string       `json:"warnings"`
+	MergedYaml string         `json:"merged_yaml"`
+	Includes   []*LintInclude `json:"includes"`
+	Jobs       []*LintJob     `json:"jobs"`
+}
+
+// Err returns the lint errors as a *CILintError, or nil when the
+// configuration is valid.
+func (r *ProjectLintResult) Err() error {
+	if r.Valid {
+		return nil
+	}
+	return &CILintError{Errors: r.Errors, Warnings: r.Warnings}
+}
+
+// CILintError is returned by ProjectLintResult.Err for an invalid
+// configuration.
+type CILintError struct {
+	Errors   []string
+	Warnings []string
+}
+
+func (e *CILintError) Error() string {
+	if len(e.Errors) == 0 {
+		return "invalid CI/CD configuration"
+	}
+	return "invalid CI/CD configuration: " + strings.Join(e.Errors, "; ")
+}
+
+// LintInclude represents a file included by a CI/CD configuration.
+type LintInclude struct {
+	Type           string         `json:"type"`
+	Location       string         `json:"location"`
+	Blob           string         `json:"blob"`
+	Raw            string         `json:"raw"`
+	Extra          map[string]any `json:"extra"`
+	ContextProject string         `json:"context_project"`
+	ContextSHA     string         `json:"context_sha"`
+}
+
+// LintJob represents a job of a linted CI/CD configuration, returned with
+// IncludeJobs.
+type LintJob struct {
+	Name         string         `json:"name"`
+	Stage        string         `json:"stage"`
+	BeforeScript []string       `json:"before_script"`
+	Script       []string       `json:"script"`
+	AfterScript  []string       `json:"after_script"`
+	TagList      []string       `json:"tag_list"`
+	Environment  string         `json:"environment"`
+	When         string         `json:"when"`
+	AllowFailure bool           `json:"allow_failure"`
+	Only         map[string]any `json:"only"`
+	Except       map[string]any `json:"except"`
+	Needs        []*LintNeed    `json:"needs"`
+}
+
+// LintNeed represents a need of a linted job.
+type LintNeed struct {
+	Name string `json:"name"`
+}
+
+// LintProjectCIConfigOptions represents the available LintProjectCIConfig()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-sample-cicd-configuration
+type LintProjectCIConfigOptions struct {
+	Content *string `json:"content,omitempty"`
+	// DryRun simulates pipeline creation instead of only validating the
+	// static configuration, so rules and needs are checked too.
+	DryRun      *bool `json:"dry_run,omitempty"`
+	IncludeJobs *bool `json:"include_jobs,omitempty"`
+	// Ref is the branch or tag used as context with DryRun. Default: the
+	// default branch.
+	Ref *string `json:"ref,omitempty"`
+}
+
+// LintProjectCIConfig validates a CI/CD configuration given as content in
+// the context of a project, so local includes resolve against it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-sample-cicd-configuration
+func (s *CILintService) LintProjectCIConfig(ctx context.Context, pid string, opts *LintProjectCIConfigOptions) (*ProjectLintResult, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/ci/lint", pid)
+	var v ProjectLintResult
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ValidateProjectCIConfigOptions represents the available
+// ValidateProjectCIConfig() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-a-projects-cicd-configuration
+type ValidateProjectCIConfigOptions struct {
+	// ContentRef is the commit SHA, branch or tag to read the configuration
+	// from. Default: the head of the default branch.
+	ContentRef *string `query:"content_ref,omitempty"`
+	DryRun     *bool   `query:"dry_run,omitempty"`
+	// DryRunRef is the branch or tag used as context with DryRun.
+	DryRunRef   *string `query:"dry_run_ref,omitempty"`
+	IncludeJobs *bool   `query:"include_jobs,omitempty"`
+}
+
+// ValidateProjectCIConfig validates the CI/CD configuration stored in a
+// project at a ref.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-a-projects-cicd-configuration
+func (s *CILintService) ValidateProjectCIConfig(ctx context.Context, pid string, opts *ValidateProjectCIConfigOptions) (*ProjectLintResult, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/ci/lint", pid)
+	var v ProjectLintResult
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// LintCIConfigFileOptions represents the available LintCIConfigFile()
+// options.
+type LintCIConfigFileOptions struct {
+	// Path of the configuration file. Default: DefaultCIConfigPath.
+	Path        string
+	DryRun      *bool
+	IncludeJobs *bool
+}
+
+// LintCIConfigFile reads a CI/CD configuration file at a ref with
+// RepositoryFilesService.GetFile and lints it in the context of the same
+// project and ref. Unlike ValidateProjectCIConfig, it also works for files
+// at a custom path.
+func (s *CILintService) LintCIConfigFile(ctx context.Context, pid, ref string, opts *LintCIConfigFileOptions) (*ProjectLintResult, error) {
+	if opts == nil {
+		opts = &LintCIConfigFileOptions{}
+	}
+	path := opts.Path
+	if path == "" {
+		path = DefaultCIConfigPath
+	}
+
+	file, err := s.client.RepositoryFiles.GetFile(ctx, pid, url.PathEscape(path), &GetFileOptions{Ref: ptr.Ptr(ref)})
+	if err != nil {
+		return nil, err
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.LintProjectCIConfig(ctx, pid, &LintProjectCIConfigOptions{
+		Content:     ptr.Ptr(string(content)),
+		DryRun:      opts.DryRun,
+		IncludeJobs: opts.IncludeJobs,
+		Ref:         ptr.Ptr(ref),
+	})
+}
@@ -0,0 +1,94 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestCILintService_LintCIConfigFile(t *testing.T) {
+	const config = "test:\n  script: make test\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/projects/1/repository/files/.gitlab-ci.yml":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"file_path": ".gitlab-ci.yml",
+				"ref":       r.URL.Query().Get("ref"),
+				"encoding":  "base64",
+				"content":   base64.StdEncoding.EncodeToString([]byte(config)),
+			})
+		case "/api/v4/projects/1/ci/lint":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["content"] != config || body["ref"] != "feature" {
+				t.Errorf("unexpected lint request: %v", body)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"valid":    false,
+				"errors":   []string{"jobs:test config contains unknown keys: scrpt"},
+				"warnings": []string{},
+				"jobs":     []map[string]any{{"name": "test", "stage": "test", "script": []string{"make test"}}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	result, err := client.CILint.LintCIConfigFile(context.Background(), "1", "feature", &gitlab.LintCIConfigFileOptions{
+		IncludeJobs: ptr.Ptr(true),
+	})
+	if err != nil {
+		t.Fatalf("CILint.LintCIConfigFile returned error: %v", err)
+	}
+	if len(result.Jobs) != 1 || result.Jobs[0].Name != "test" {
+		t.Errorf("unexpected jobs: %+v", result.Jobs)
+	}
+	var lintErr *gitlab.CILintError
+	if !errors.As(result.Err(), &lintErr) || len(lintErr.Errors) != 1 {
+		t.Errorf("unexpected lint error: %v", result.Err())
+	}
+}
+
+func TestCILintService_LintCIConfigFile_NestedPath(t *testing.T) {
+	const config = "test:\n  script: make test\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/1/repository/files/ci%2Fmain.yml":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"file_path": "ci/main.yml",
+				"ref":       r.URL.Query().Get("ref"),
+				"encoding":  "base64",
+				"content":   base64.StdEncoding.EncodeToString([]byte(config)),
+			})
+		case "/api/v4/projects/1/ci/lint":
+			_ = json.NewEncoder(w).Encode(map[string]any{"valid": true, "errors": []string{}, "warnings": []string{}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	result, err := client.CILint.LintCIConfigFile(context.Background(), "1", "main", &gitlab.LintCIConfigFileOptions{
+		Path: "ci/main.yml",
+	})
+	if err != nil {
+		t.Fatalf("CILint.LintCIConfigFile returned error: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
@@ -0,0 +1,244 @@
+// Package ciconfig is a typed model of the GitLab CI/CD configuration file,
+// .gitlab-ci.yml.
+//
+// Parse decodes a configuration into a Config, with anchors, aliases and
+// merge keys expanded; Marshal writes it back with a stable key order, so
+// generated files diff cleanly. Keywords without a dedicated field are kept
+// in the Extra maps and survive a round trip. A Resolver merges in the files
+// referenced by include.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/
+package ciconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config represents a .gitlab-ci.yml file.
+type Config struct {
+	// Spec is the header section of a file with spec:inputs, written as a
+	// separate YAML document before the configuration.
+	Spec      map[string]any
+	Include   []*Include
+	Default   *Default
+	Workflow  *Workflow
+	Stages    []string
+	Variables Variables
+	// Jobs holds the jobs in file order, hidden jobs (names starting with
+	// ".") included.
+	Jobs []*Job
+	// Extra holds the other top-level keys: deprecated global keywords such
+	// as image and before_script, and hidden keys that are not jobs, such as
+	// anchored script lists.
+	Extra map[string]any
+}
+
+// Parse decodes a .gitlab-ci.yml file.
+func Parse(data []byte) (*Config, error) {
+	spec, body, err := splitDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if spec != nil {
+		if err = spec.Decode(&c.Spec); err != nil {
+			return nil, err
+		}
+	}
+	if body != nil {
+		if err = body.Decode(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Marshal encodes c as YAML. Top-level keys are written in the order
+// include, default, workflow, stages, variables, the Extra keys sorted by
+// name, then the jobs in order.
+func Marshal(c *Config) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if c.Spec != nil {
+		if err := enc.Encode(map[string]any{"spec": c.Spec}); err != nil {
+			return nil, err
+		}
+	}
+	if err := enc.Encode(c); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Job returns the job with the given name, or nil.
+func (c *Config) Job(name string) *Job {
+	for _, j := range c.Jobs {
+		if j.Name == name {
+			return j
+		}
+	}
+	return nil
+}
+
+// splitDocuments returns the spec header, if any, and the configuration
+// mapping of a file.
+func splitDocuments(data []byte) (spec, body *yaml.Node, err error) {
+	var docs []*yaml.Node
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err = dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, nil, err
+		}
+		if len(doc.Content) > 0 {
+			docs = append(docs, doc.Content[0])
+		}
+	}
+
+	switch len(docs) {
+	case 0:
+		return nil, nil, nil
+	case 1:
+		body = docs[0]
+	case 2:
+		header := docs[0]
+		if header.Kind != yaml.MappingNode || len(header.Content) != 2 || header.Content[0].Value != "spec" {
+			return nil, nil, errors.New("ciconfig: the first of two documents must be a spec header")
+		}
+		spec, body = header.Content[1], docs[1]
+	default:
+		return nil, nil, fmt.Errorf("ciconfig: want at most 2 documents, got %d", len(docs))
+	}
+	if body.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("ciconfig: line %d: configuration must be a mapping", body.Line)
+	}
+	return spec, body, nil
+}
+
+func (c *Config) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("ciconfig: line %d: configuration must be a mapping", node.Line)
+	}
+	*c = Config{Spec: c.Spec}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], resolve(node.Content[i+1])
+		if key.Tag == "!!merge" {
+			return fmt.Errorf("ciconfig: line %d: top-level merge keys are not supported", key.Line)
+		}
+
+		var err error
+		switch key.Value {
+		case "include":
+			c.Include, err = decodeIncludes(value)
+		case "default":
+			err = value.Decode(&c.Default)
+		case "workflow":
+			err = value.Decode(&c.Workflow)
+		case "stages":
+			err = value.Decode(&c.Stages)
+		case "variables":
+			err = value.Decode(&c.Variables)
+		default:
+			if value.Kind == yaml.MappingNode && !globalKeywords[key.Value] {
+				job := &Job{}
+				if err = value.Decode(job); err == nil {
+					job.Name = key.Value
+					c.Jobs = append(c.Jobs, job)
+				}
+				break
+			}
+			var v any
+			if err = value.Decode(&v); err == nil {
+				if c.Extra == nil {
+					c.Extra = make(map[string]any)
+				}
+				c.Extra[key.Value] = v
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("ciconfig: %s: %w", key.Value, err)
+		}
+	}
+	return nil
+}
+
+// globalKeywords are the deprecated global keywords, kept in Config.Extra.
+var globalKeywords = map[string]bool{
+	"image": true, "services": true, "cache": true,
+	"before_script": true, "after_script": true,
+}
+
+func (c *Config) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	var err error
+	add := func(key string, v any) {
+		if err == nil {
+			err = appendPair(node, key, v)
+		}
+	}
+	if len(c.Include) > 0 {
+		add("include", c.Include)
+	}
+	if c.Default != nil {
+		add("default", c.Default)
+	}
+	if c.Workflow != nil {
+		add("workflow", c.Workflow)
+	}
+	if len(c.Stages) > 0 {
+		add("stages", c.Stages)
+	}
+	if len(c.Variables) > 0 {
+		add("variables", c.Variables)
+	}
+	keys := make([]string, 0, len(c.Extra))
+	for k := range c.Extra {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		add(k, c.Extra[k])
+	}
+	for _, j := range c.Jobs {
+		add(j.Name, j)
+	}
+	return node, err
+}
+
+// appendPair encodes v and appends it to the mapping node under key.
+func appendPair(node *yaml.Node, key string, v any) error {
+	value := &yaml.Node{}
+	if err := value.Encode(v); err != nil {
+		return err
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+	return nil
+}
+
+// resolve follows an alias to the node it refers to.
+func resolve(node *yaml.Node) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// IsHidden reports whether the job is hidden: its name starts with a dot,
+// so it is only used as a template for extends or anchors.
+func (j *Job) IsHidden() bool {
+	return strings.HasPrefix(j.Name, ".")
+}
@@ -0,0 +1,165 @@
+package ciconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleConfig = `
+include:
+  - local: /ci/build.yml
+  - project: group/shared
+    ref: v1
+    file: [lint.yml, deploy.yml]
+  - template: Security/SAST.gitlab-ci.yml
+
+stages: [build, test, deploy]
+
+variables:
+  GO_VERSION: "1.21"
+  DEPLOY_ENV:
+    value: staging
+    description: Target environment
+    options: [staging, production]
+
+.go: &go
+  image: golang:1.21
+  tags: [docker]
+
+.setup: &setup
+  - go mod download
+
+test:
+  <<: *go
+  stage: test
+  extends: .base
+  needs:
+    - build
+    - job: lint
+      artifacts: false
+  script:
+    - *setup
+    - go test ./...
+  rules:
+    - if: $CI_PIPELINE_SOURCE == "merge_request_event"
+      changes: ["**/*.go"]
+    - when: never
+  allow_failure:
+    exit_codes: 42
+  artifacts:
+    reports:
+      junit: report.xml
+
+deploy:
+  stage: deploy
+  needs: []
+  script: ./deploy.sh
+  environment: production
+`
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(sampleConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Include) != 3 || c.Include[0].Local != "/ci/build.yml" || len(c.Include[1].File) != 2 {
+		t.Errorf("unexpected include: %+v", c.Include)
+	}
+	if v := c.Variables.Get("DEPLOY_ENV"); v == nil || v.Value != "staging" || len(v.Options) != 2 {
+		t.Errorf("unexpected DEPLOY_ENV: %+v", v)
+	}
+	if c.Variables[0].Name != "GO_VERSION" {
+		t.Errorf("variables out of order: %s", c.Variables[0].Name)
+	}
+	if _, ok := c.Extra[".setup"]; !ok {
+		t.Errorf(".setup not kept in Extra: %v", c.Extra)
+	}
+
+	var names []string
+	for _, j := range c.Jobs {
+		names = append(names, j.Name)
+	}
+	if want := ".go test deploy"; strings.Join(names, " ") != want {
+		t.Errorf("got jobs %q, want %q", strings.Join(names, " "), want)
+	}
+
+	test := c.Job("test")
+	if test.Image == nil || test.Image.Name != "golang:1.21" || len(test.Tags) != 1 {
+		t.Errorf("merge key not applied: %+v", test)
+	}
+	if want := "go mod download,go test ./..."; strings.Join(test.Script, ",") != want {
+		t.Errorf("got script %q, want %q", strings.Join(test.Script, ","), want)
+	}
+	if test.Needs == nil || len(*test.Needs) != 2 || (*test.Needs)[1].Job != "lint" || *(*test.Needs)[1].Artifacts {
+		t.Errorf("unexpected needs: %+v", test.Needs)
+	}
+	if len(test.Rules) != 2 || test.Rules[0].Changes == nil || test.Rules[0].Changes.Paths[0] != "**/*.go" {
+		t.Errorf("unexpected rules: %+v", test.Rules)
+	}
+	if test.AllowFailure == nil || !test.AllowFailure.Allowed || test.AllowFailure.ExitCodes[0] != 42 {
+		t.Errorf("unexpected allow_failure: %+v", test.AllowFailure)
+	}
+	if _, ok := test.Extra["artifacts"]; !ok {
+		t.Errorf("artifacts not kept in Extra: %v", test.Extra)
+	}
+
+	deploy := c.Job("deploy")
+	if deploy.Needs == nil || len(*deploy.Needs) != 0 {
+		t.Errorf("needs: [] not kept: %+v", deploy.Needs)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	c, err := Parse([]byte(sampleConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := Parse(out)
+	if err != nil {
+		t.Fatalf("output does not parse: %v\n%s", err, out)
+	}
+	out2, err := Marshal(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(out2) {
+		t.Errorf("marshal is not stable:\n%s\n---\n%s", out, out2)
+	}
+
+	const small = `variables:
+  B: "2"
+  A: "1"
+stages:
+  - build
+build:
+  needs: []
+  script: make
+`
+	c, err = Parse([]byte(small))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err = Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `stages:
+  - build
+variables:
+  B: "2"
+  A: "1"
+build:
+  needs: []
+  script:
+    - make
+`
+	if string(out) != want {
+		t.Errorf("got\n%s\nwant\n%s", out, want)
+	}
+}
@@ -0,0 +1,297 @@
+package ciconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/nexuer/go-gitlab"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultTemplateProject is the project the CI/CD templates are read from.
+	DefaultTemplateProject = "gitlab-org/gitlab"
+	// DefaultTemplateRef is the ref the CI/CD templates are read at.
+	DefaultTemplateRef = "master"
+	// TemplatePath is the directory of the CI/CD templates in the template
+	// project.
+	TemplatePath = "lib/gitlab/ci/templates"
+	// DefaultMaxIncludes is the GitLab default limit of included files per
+	// pipeline.
+	DefaultMaxIncludes = 150
+)
+
+var (
+	ErrUnsupportedInclude = errors.New("ciconfig: unsupported include")
+	ErrIncludeCycle       = errors.New("ciconfig: include cycle")
+	ErrTooManyIncludes    = errors.New("ciconfig: too many includes")
+)
+
+// FileGetter reads repository files. *gitlab.RepositoryFilesService
+// implements it. The project and file path are passed URL path-escaped,
+// as GitLab expects them.
+type FileGetter interface {
+	GetFile(ctx context.Context, projectID string, filepath string, opts *gitlab.GetFileOptions) (*gitlab.File, error)
+}
+
+var _ FileGetter = (*gitlab.RepositoryFilesService)(nil)
+
+// Resolver resolves local, project and template includes by reading the
+// included files through a FileGetter and merging them the way GitLab does:
+// included files are merged in order, then the including file is merged
+// over them. Mappings are merged key by key, other values are replaced.
+//
+// Include rules are not evaluated: every include is merged. Remote and
+// component includes, and local includes with wildcards, fail with
+// ErrUnsupportedInclude unless SkipUnsupported is set.
+type Resolver struct {
+	Files FileGetter
+	// Templates reads the CI/CD templates. Default: Files, which only works
+	// against GitLab.com; point it at a GitLab.com client on self-managed
+	// instances.
+	Templates       FileGetter
+	TemplateProject string
+	TemplateRef     string
+	// MaxIncludes limits the number of included files. Default:
+	// DefaultMaxIncludes.
+	MaxIncludes     int
+	SkipUnsupported bool
+}
+
+func NewResolver(files FileGetter) *Resolver {
+	return &Resolver{
+		Files:           files,
+		TemplateProject: DefaultTemplateProject,
+		TemplateRef:     DefaultTemplateRef,
+		MaxIncludes:     DefaultMaxIncludes,
+	}
+}
+
+// Resolve parses data, the configuration of project at ref, and returns it
+// with every include merged in. The returned Config has no Include.
+func (r *Resolver) Resolve(ctx context.Context, project, ref string, data []byte) (*Config, error) {
+	spec, body, err := splitDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if spec != nil {
+		if err = spec.Decode(&c.Spec); err != nil {
+			return nil, err
+		}
+	}
+	if body == nil {
+		return c, nil
+	}
+
+	st := &resolveState{chain: make(map[string]bool)}
+	merged, err := r.resolve(ctx, st, includeSource{project: project, ref: ref}, body)
+	if err != nil {
+		return nil, err
+	}
+	if err = merged.Decode(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ResolveFile reads the configuration at path in project at ref and
+// resolves it.
+func (r *Resolver) ResolveFile(ctx context.Context, project, ref, filePath string) (*Config, error) {
+	data, err := r.fetch(ctx, r.Files, project, ref, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.Resolve(ctx, project, ref, data)
+}
+
+type resolveState struct {
+	count int
+	chain map[string]bool
+}
+
+// includeSource is where a file comes from; local includes resolve against
+// the source of the including file.
+type includeSource struct {
+	getter       FileGetter
+	project, ref string
+	path         string
+}
+
+func (s includeSource) String() string {
+	return s.project + "@" + s.ref + ":" + s.path
+}
+
+// resolve returns body with its includes merged in.
+func (r *Resolver) resolve(ctx context.Context, st *resolveState, src includeSource, body *yaml.Node) (*yaml.Node, error) {
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	own := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	var includes []*Include
+	for i := 0; i+1 < len(body.Content); i += 2 {
+		if body.Content[i].Value == "include" {
+			var err error
+			if includes, err = decodeIncludes(body.Content[i+1]); err != nil {
+				return nil, fmt.Errorf("ciconfig: %s: include: %w", src.path, err)
+			}
+			continue
+		}
+		own.Content = append(own.Content, body.Content[i], body.Content[i+1])
+	}
+
+	for _, inc := range includes {
+		sources, err := r.sources(src, inc)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sources {
+			key := s.String()
+			if st.chain[key] {
+				return nil, fmt.Errorf("%w: %s", ErrIncludeCycle, key)
+			}
+			if st.count++; st.count > r.maxIncludes() {
+				return nil, fmt.Errorf("%w: more than %d", ErrTooManyIncludes, r.maxIncludes())
+			}
+
+			data, err := r.fetch(ctx, s.getter, s.project, s.ref, s.path)
+			if err != nil {
+				return nil, fmt.Errorf("ciconfig: include %s: %w", key, err)
+			}
+			_, included, err := splitDocuments(data)
+			if err != nil {
+				return nil, fmt.Errorf("ciconfig: include %s: %w", key, err)
+			}
+			if included == nil {
+				continue
+			}
+
+			st.chain[key] = true
+			node, err := r.resolve(ctx, st, s, included)
+			delete(st.chain, key)
+			if err != nil {
+				return nil, err
+			}
+			mergeMappings(merged, node)
+		}
+	}
+	mergeMappings(merged, own)
+	return merged, nil
+}
+
+// sources lists the files an include refers to.
+func (r *Resolver) sources(from includeSource, inc *Include) ([]includeSource, error) {
+	switch {
+	case inc.Local != "":
+		if strings.Contains(inc.Local, "*") {
+			return r.unsupported("local include with wildcard " + inc.Local)
+		}
+		return []includeSource{{
+			getter:  from.getter,
+			project: from.project,
+			ref:     from.ref,
+			path:    strings.TrimPrefix(inc.Local, "/"),
+		}}, nil
+	case inc.Project != "":
+		ref := inc.Ref
+		if ref == "" {
+			ref = "HEAD"
+		}
+		sources := make([]includeSource, 0, len(inc.File))
+		for _, f := range inc.File {
+			sources = append(sources, includeSource{
+				getter:  r.Files,
+				project: inc.Project,
+				ref:     ref,
+				path:    strings.TrimPrefix(f, "/"),
+			})
+		}
+		return sources, nil
+	case inc.Template != "":
+		getter := r.Templates
+		if getter == nil {
+			getter = r.Files
+		}
+		project, ref := r.TemplateProject, r.TemplateRef
+		if project == "" {
+			project = DefaultTemplateProject
+		}
+		if ref == "" {
+			ref = DefaultTemplateRef
+		}
+		return []includeSource{{
+			getter:  getter,
+			project: project,
+			ref:     ref,
+			path:    path.Join(TemplatePath, inc.Template),
+		}}, nil
+	case inc.Remote != "":
+		return r.unsupported("remote include " + inc.Remote)
+	case inc.Component != "":
+		return r.unsupported("component include " + inc.Component)
+	default:
+		return r.unsupported("empty include")
+	}
+}
+
+func (r *Resolver) unsupported(what string) ([]includeSource, error) {
+	if r.SkipUnsupported {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedInclude, what)
+}
+
+func (r *Resolver) maxIncludes() int {
+	if r.MaxIncludes > 0 {
+		return r.MaxIncludes
+	}
+	return DefaultMaxIncludes
+}
+
+func (r *Resolver) fetch(ctx context.Context, getter FileGetter, project, ref, filePath string) ([]byte, error) {
+	if getter == nil {
+		getter = r.Files
+	}
+	if getter == nil {
+		return nil, errors.New("ciconfig: Resolver has no FileGetter")
+	}
+	file, err := getter.GetFile(ctx, url.PathEscape(project), url.PathEscape(filePath), &gitlab.GetFileOptions{Ref: &ref})
+	if err != nil {
+		return nil, err
+	}
+	return file.GetContent()
+}
+
+// mergeMappings deep merges src into dst. Mappings shared through anchors
+// are copied before being changed.
+func mergeMappings(dst, src *yaml.Node) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		j := indexKey(dst, key.Value)
+		if j < 0 {
+			dst.Content = append(dst.Content, key, value)
+			continue
+		}
+		old, nv := resolve(dst.Content[j+1]), resolve(value)
+		if old.Kind == yaml.MappingNode && nv.Kind == yaml.MappingNode && key.Tag != "!!merge" {
+			cp := *old
+			cp.Anchor = ""
+			cp.Content = append([]*yaml.Node(nil), old.Content...)
+			mergeMappings(&cp, nv)
+			dst.Content[j+1] = &cp
+			continue
+		}
+		dst.Content[j+1] = value
+	}
+}
+
+func indexKey(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
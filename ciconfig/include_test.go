@@ -0,0 +1,121 @@
+package ciconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+)
+
+// fakeFiles serves files keyed by project@ref:path.
+type fakeFiles map[string]string
+
+func (f fakeFiles) GetFile(_ context.Context, projectID string, filepath string, opts *gitlab.GetFileOptions) (*gitlab.File, error) {
+	project, _ := url.PathUnescape(projectID)
+	p, _ := url.PathUnescape(filepath)
+	content, ok := f[project+"@"+*opts.Ref+":"+p]
+	if !ok {
+		return nil, &gitlab.Error{Message: "404 File Not Found"}
+	}
+	return &gitlab.File{
+		FilePath: p,
+		Encoding: "base64",
+		Content:  base64.StdEncoding.EncodeToString([]byte(content)),
+	}, nil
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	files := fakeFiles{
+		"app@main:ci/build.yml": `
+include: ci/common.yml
+build:
+  stage: build
+  script: make
+`,
+		"app@main:ci/common.yml": `
+variables:
+  GOFLAGS: -mod=vendor
+  CGO_ENABLED: "0"
+`,
+		"group/shared@v1:lint.yml": `
+include: /local.yml
+lint:
+  stage: test
+  script: golangci-lint run
+`,
+		"group/shared@v1:local.yml": `
+.shared:
+  tags: [shared]
+`,
+		"gitlab-org/gitlab@master:lib/gitlab/ci/templates/Security/SAST.gitlab-ci.yml": `
+sast:
+  stage: test
+  variables:
+    SAST_EXCLUDED_PATHS: spec
+  script: /analyzer run
+`,
+	}
+
+	root := `
+include:
+  - local: /ci/build.yml
+  - project: group/shared
+    ref: v1
+    file: lint.yml
+  - template: Security/SAST.gitlab-ci.yml
+variables:
+  CGO_ENABLED: "1"
+sast:
+  variables:
+    SAST_EXCLUDED_PATHS: vendor
+`
+	c, err := NewResolver(files).Resolve(context.Background(), "app", "main", []byte(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Include) != 0 {
+		t.Errorf("include not removed: %+v", c.Include)
+	}
+	if v := c.Variables.Get("CGO_ENABLED"); v == nil || v.Value != "1" {
+		t.Errorf("root variable not merged over include: %+v", v)
+	}
+	if v := c.Variables.Get("GOFLAGS"); v == nil {
+		t.Errorf("nested include not merged")
+	}
+	for _, name := range []string{"build", "lint", ".shared", "sast"} {
+		if c.Job(name) == nil {
+			t.Errorf("job %s missing", name)
+		}
+	}
+	sast := c.Job("sast")
+	if sast.Stage != "test" || sast.Variables.Get("SAST_EXCLUDED_PATHS").Value != "vendor" {
+		t.Errorf("template not deep merged: %+v", sast)
+	}
+}
+
+func TestResolver_Errors(t *testing.T) {
+	files := fakeFiles{
+		"app@main:a.yml": "include: b.yml\n",
+		"app@main:b.yml": "include: a.yml\n",
+	}
+	r := NewResolver(files)
+
+	_, err := r.Resolve(context.Background(), "app", "main", []byte("include: a.yml\n"))
+	if !errors.Is(err, ErrIncludeCycle) {
+		t.Errorf("got %v, want %v", err, ErrIncludeCycle)
+	}
+
+	_, err = r.Resolve(context.Background(), "app", "main", []byte("include: https://example.com/ci.yml\n"))
+	if !errors.Is(err, ErrUnsupportedInclude) {
+		t.Errorf("got %v, want %v", err, ErrUnsupportedInclude)
+	}
+
+	r.SkipUnsupported = true
+	if _, err = r.Resolve(context.Background(), "app", "main", []byte("include: https://example.com/ci.yml\n")); err != nil {
+		t.Errorf("SkipUnsupported: %v", err)
+	}
+}
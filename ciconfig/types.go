@@ -0,0 +1,379 @@
+package ciconfig
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Job represents a job. Keywords without a field, such as artifacts, cache,
+// services or trigger, are kept in Extra.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#job-keywords
+type Job struct {
+	Name          string        `yaml:"-"`
+	Extends       StringList    `yaml:"extends,omitempty"`
+	Stage         string        `yaml:"stage,omitempty"`
+	Image         *Image        `yaml:"image,omitempty"`
+	Variables     Variables     `yaml:"variables,omitempty"`
+	Rules         []*Rule       `yaml:"rules,omitempty"`
+	Needs         *Needs        `yaml:"needs,omitempty"`
+	Dependencies  *[]string     `yaml:"dependencies,omitempty"`
+	BeforeScript  StringList    `yaml:"before_script,omitempty"`
+	Script        StringList    `yaml:"script,omitempty"`
+	AfterScript   StringList    `yaml:"after_script,omitempty"`
+	When          string        `yaml:"when,omitempty"`
+	AllowFailure  *AllowFailure `yaml:"allow_failure,omitempty"`
+	Tags          []string      `yaml:"tags,omitempty"`
+	Timeout       string        `yaml:"timeout,omitempty"`
+	Interruptible *bool         `yaml:"interruptible,omitempty"`
+	ResourceGroup string        `yaml:"resource_group,omitempty"`
+	Coverage      string        `yaml:"coverage,omitempty"`
+
+	Extra map[string]any `yaml:",inline"`
+}
+
+// Default represents the default keyword, whose values apply to every job
+// that does not set them.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#default
+type Default struct {
+	Image         *Image     `yaml:"image,omitempty"`
+	BeforeScript  StringList `yaml:"before_script,omitempty"`
+	AfterScript   StringList `yaml:"after_script,omitempty"`
+	Tags          []string   `yaml:"tags,omitempty"`
+	Timeout       string     `yaml:"timeout,omitempty"`
+	Interruptible *bool      `yaml:"interruptible,omitempty"`
+
+	Extra map[string]any `yaml:",inline"`
+}
+
+// Workflow represents the workflow keyword.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#workflow
+type Workflow struct {
+	Name  string  `yaml:"name,omitempty"`
+	Rules []*Rule `yaml:"rules,omitempty"`
+
+	Extra map[string]any `yaml:",inline"`
+}
+
+// Rule represents an entry of rules, in jobs, workflow and include.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#rules
+type Rule struct {
+	If            string        `yaml:"if,omitempty"`
+	Changes       *RuleChanges  `yaml:"changes,omitempty"`
+	Exists        *RuleExists   `yaml:"exists,omitempty"`
+	When          string        `yaml:"when,omitempty"`
+	AllowFailure  *AllowFailure `yaml:"allow_failure,omitempty"`
+	StartIn       string        `yaml:"start_in,omitempty"`
+	Variables     Variables     `yaml:"variables,omitempty"`
+	Needs         *Needs        `yaml:"needs,omitempty"`
+	Interruptible *bool         `yaml:"interruptible,omitempty"`
+}
+
+// RuleChanges represents rules:changes, a list of paths or a mapping with
+// paths and compare_to.
+type RuleChanges struct {
+	Paths     []string `yaml:"paths,omitempty"`
+	CompareTo string   `yaml:"compare_to,omitempty"`
+}
+
+func (c *RuleChanges) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		*c = RuleChanges{}
+		return node.Decode(&c.Paths)
+	}
+	type plain RuleChanges
+	return node.Decode((*plain)(c))
+}
+
+func (c RuleChanges) MarshalYAML() (any, error) {
+	if c.CompareTo == "" {
+		return c.Paths, nil
+	}
+	type plain RuleChanges
+	return plain(c), nil
+}
+
+// RuleExists represents rules:exists, a list of paths or a mapping with
+// paths, project and ref.
+type RuleExists struct {
+	Paths   []string `yaml:"paths,omitempty"`
+	Project string   `yaml:"project,omitempty"`
+	Ref     string   `yaml:"ref,omitempty"`
+}
+
+func (e *RuleExists) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		*e = RuleExists{}
+		return node.Decode(&e.Paths)
+	}
+	type plain RuleExists
+	return node.Decode((*plain)(e))
+}
+
+func (e RuleExists) MarshalYAML() (any, error) {
+	if e.Project == "" && e.Ref == "" {
+		return e.Paths, nil
+	}
+	type plain RuleExists
+	return plain(e), nil
+}
+
+// Needs represents the needs keyword. An empty, non-nil Needs is written as
+// needs: [], which starts the job as soon as the pipeline is created.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#needs
+type Needs []*Need
+
+// Need is one entry of needs, either a job name or a mapping.
+type Need struct {
+	Job       string `yaml:"job,omitempty"`
+	Project   string `yaml:"project,omitempty"`
+	Ref       string `yaml:"ref,omitempty"`
+	Pipeline  string `yaml:"pipeline,omitempty"`
+	Artifacts *bool  `yaml:"artifacts,omitempty"`
+	Optional  bool   `yaml:"optional,omitempty"`
+
+	Extra map[string]any `yaml:",inline"`
+}
+
+func (n *Need) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*n = Need{Job: node.Value}
+		return nil
+	}
+	type plain Need
+	return node.Decode((*plain)(n))
+}
+
+func (n Need) MarshalYAML() (any, error) {
+	if n.Project == "" && n.Ref == "" && n.Pipeline == "" && n.Artifacts == nil && !n.Optional && len(n.Extra) == 0 {
+		return n.Job, nil
+	}
+	type plain Need
+	return plain(n), nil
+}
+
+// Image represents the image keyword, either a name or a mapping.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#image
+type Image struct {
+	Name       string     `yaml:"name,omitempty"`
+	Entrypoint []string   `yaml:"entrypoint,omitempty"`
+	PullPolicy StringList `yaml:"pull_policy,omitempty"`
+
+	Extra map[string]any `yaml:",inline"`
+}
+
+func (i *Image) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*i = Image{Name: node.Value}
+		return nil
+	}
+	type plain Image
+	return node.Decode((*plain)(i))
+}
+
+func (i Image) MarshalYAML() (any, error) {
+	if len(i.Entrypoint) == 0 && len(i.PullPolicy) == 0 && len(i.Extra) == 0 {
+		return i.Name, nil
+	}
+	type plain Image
+	return plain(i), nil
+}
+
+// AllowFailure represents allow_failure, either a boolean or a mapping with
+// exit_codes. A job with ExitCodes is only allowed to fail with those codes.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#allow_failure
+type AllowFailure struct {
+	Allowed   bool
+	ExitCodes []int
+}
+
+func (a *AllowFailure) UnmarshalYAML(node *yaml.Node) error {
+	*a = AllowFailure{}
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&a.Allowed)
+	}
+	var v struct {
+		ExitCodes yaml.Node `yaml:"exit_codes"`
+	}
+	if err := node.Decode(&v); err != nil {
+		return err
+	}
+	a.Allowed = true
+	if v.ExitCodes.Kind == yaml.ScalarNode {
+		var code int
+		if err := v.ExitCodes.Decode(&code); err != nil {
+			return err
+		}
+		a.ExitCodes = []int{code}
+		return nil
+	}
+	return v.ExitCodes.Decode(&a.ExitCodes)
+}
+
+func (a AllowFailure) MarshalYAML() (any, error) {
+	if len(a.ExitCodes) == 0 {
+		return a.Allowed, nil
+	}
+	return map[string][]int{"exit_codes": a.ExitCodes}, nil
+}
+
+// StringList is a list of strings that may be written as a single string,
+// such as extends, or as nested lists, such as scripts built from anchors.
+// Nested lists are flattened.
+type StringList []string
+
+func (l *StringList) UnmarshalYAML(node *yaml.Node) error {
+	*l = nil
+	return l.append(node)
+}
+
+func (l *StringList) append(node *yaml.Node) error {
+	node = resolve(node)
+	switch node.Kind {
+	case yaml.ScalarNode:
+		*l = append(*l, node.Value)
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if err := l.append(item); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("line %d: want a string or a list of strings", node.Line)
+	}
+	return nil
+}
+
+// Variables represents the variables keyword in file order.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#variables
+type Variables []*Variable
+
+// Variable is one entry of variables. Variables with only a value are
+// written in the short form NAME: value.
+type Variable struct {
+	Name        string   `yaml:"-"`
+	Value       string   `yaml:"value"`
+	Description string   `yaml:"description,omitempty"`
+	Options     []string `yaml:"options,omitempty"`
+	Expand      *bool    `yaml:"expand,omitempty"`
+}
+
+// Get returns the variable with the given name, or nil.
+func (vs Variables) Get(name string) *Variable {
+	for _, v := range vs {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func (vs *Variables) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: variables must be a mapping", node.Line)
+	}
+	*vs = make(Variables, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], resolve(node.Content[i+1])
+		v := &Variable{}
+		switch value.Kind {
+		case yaml.ScalarNode:
+			v.Value = value.Value
+		case yaml.MappingNode:
+			if err := value.Decode(v); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("line %d: variable %s must be a string or a mapping", value.Line, key.Value)
+		}
+		v.Name = key.Value
+		*vs = append(*vs, v)
+	}
+	return nil
+}
+
+func (vs Variables) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, v := range vs {
+		if v.Description == "" && len(v.Options) == 0 && v.Expand == nil {
+			if err := appendPair(node, v.Name, v.Value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := appendPair(node, v.Name, *v); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// Include represents one entry of include. The short form, a string, is
+// decoded into Remote for URLs and into Local otherwise.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/#include
+type Include struct {
+	Local     string         `yaml:"local,omitempty"`
+	Project   string         `yaml:"project,omitempty"`
+	Ref       string         `yaml:"ref,omitempty"`
+	File      StringList     `yaml:"file,omitempty"`
+	Template  string         `yaml:"template,omitempty"`
+	Remote    string         `yaml:"remote,omitempty"`
+	Component string         `yaml:"component,omitempty"`
+	Inputs    map[string]any `yaml:"inputs,omitempty"`
+	Rules     []*Rule        `yaml:"rules,omitempty"`
+}
+
+func (i *Include) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*i = Include{}
+		if isURL(node.Value) {
+			i.Remote = node.Value
+		} else {
+			i.Local = node.Value
+		}
+		return nil
+	}
+	type plain Include
+	return node.Decode((*plain)(i))
+}
+
+func (i Include) MarshalYAML() (any, error) {
+	short := i.Project == "" && len(i.File) == 0 && i.Template == "" && i.Component == "" &&
+		len(i.Inputs) == 0 && len(i.Rules) == 0
+	switch {
+	case short && i.Remote == "" && i.Local != "" && !isURL(i.Local):
+		return i.Local, nil
+	case short && i.Local == "" && i.Remote != "":
+		return i.Remote, nil
+	}
+	type plain Include
+	return plain(i), nil
+}
+
+// decodeIncludes decodes include, which is a single entry or a list.
+func decodeIncludes(node *yaml.Node) ([]*Include, error) {
+	node = resolve(node)
+	if node.Kind != yaml.SequenceNode {
+		i := &Include{}
+		if err := node.Decode(i); err != nil {
+			return nil, err
+		}
+		return []*Include{i}, nil
+	}
+	var includes []*Include
+	err := node.Decode(&includes)
+	return includes, err
+}
+
+func isURL(s string) bool {
+	return len(s) > 8 && (s[:7] == "http://" || s[:8] == "https://")
+}
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"errors"
 	"net/http"
+	"sync/atomic"
 )
 
 var (
@@ -26,9 +27,31 @@ type Credential interface {
 // TokenCredential
 // Docs: https://docs.gitlab.com/ee/api/rest/#authentication
 type TokenCredential struct {
-	Endpoint    string    `json:"endpoint" xml:"endpoint"`
-	TokenType   TokenType `json:"type" xml:"type"`
-	AccessToken string    `json:"token" xml:"token"`
+	Endpoint  string    `json:"endpoint" xml:"endpoint"`
+	TokenType TokenType `json:"type" xml:"type"`
+	// AccessToken is the initial token. It is read without locking, so it
+	// must not be written once the credential is in use; rotate the token
+	// with SetAccessToken instead.
+	AccessToken string `json:"token" xml:"token"`
+
+	// token holds the string set by SetAccessToken. atomic.Value keeps
+	// TokenCredential copyable without tripping vet's copylocks check.
+	token atomic.Value
+}
+
+// SetAccessToken replaces the token atomically, so requests in flight on
+// other goroutines see either the old or the new token, never a mix.
+func (t *TokenCredential) SetAccessToken(token string) {
+	t.token.Store(token)
+}
+
+// Token returns the current token: the last one set by SetAccessToken, or
+// AccessToken if none was.
+func (t *TokenCredential) Token() string {
+	if token, ok := t.token.Load().(string); ok {
+		return token
+	}
+	return t.AccessToken
 }
 
 func (t *TokenCredential) GetEndpoint() string {
@@ -40,16 +63,17 @@ func (t *TokenCredential) RequestBody(opts *GetAccessTokenOptions) any {
 }
 
 func (t *TokenCredential) Auth(req *http.Request, token *AccessToken) error {
-	if t.AccessToken == "" {
+	accessToken := t.Token()
+	if accessToken == "" {
 		return errors.New("TokenCredential: no access token")
 	}
 	switch t.TokenType {
 	case JobToken:
-		req.Header.Set("JOB-TOKEN", t.AccessToken)
+		req.Header.Set("JOB-TOKEN", accessToken)
 	case PrivateToken:
-		req.Header.Set("PRIVATE-TOKEN", t.AccessToken)
+		req.Header.Set("PRIVATE-TOKEN", accessToken)
 	default:
-		req.Header.Set("Authorization", "Bearer "+t.AccessToken)
+		req.Header.Set("Authorization", "Bearer "+accessToken)
 	}
 	return nil
 }
@@ -120,3 +144,80 @@ func (p *PasswordCredential) Auth(req *http.Request, token *AccessToken) error {
 	}
 	return nil
 }
+
+// DeployTokenCredential authenticates with a deploy token over HTTP Basic
+// auth. Deploy tokens are only accepted by the package registry, the
+// container registry and Git over HTTP, not by the rest of the REST API.
+// docs: https://docs.gitlab.com/ee/user/project/deploy_tokens/
+type DeployTokenCredential struct {
+	Endpoint string `json:"endpoint" xml:"endpoint"`
+	Username string `json:"username" xml:"username"`
+	Token    string `json:"token" xml:"token"`
+}
+
+func (d *DeployTokenCredential) GetEndpoint() string {
+	return d.Endpoint
+}
+
+func (d *DeployTokenCredential) RequestBody(opts *GetAccessTokenOptions) any {
+	return nil
+}
+
+func (d *DeployTokenCredential) Auth(req *http.Request, token *AccessToken) error {
+	if d.Username == "" || d.Token == "" {
+		return errors.New("DeployTokenCredential: no username or token")
+	}
+	req.SetBasicAuth(d.Username, d.Token)
+	return nil
+}
+
+// BasicAuthCredential authenticates with HTTP Basic auth, for endpoints
+// that accept a username with a password or token, such as the package
+// registry (a personal access token or CI_JOB_TOKEN as password).
+type BasicAuthCredential struct {
+	Endpoint string `json:"endpoint" xml:"endpoint"`
+	Username string `json:"username" xml:"username"`
+	Password string `json:"password" xml:"password"`
+}
+
+func (b *BasicAuthCredential) GetEndpoint() string {
+	return b.Endpoint
+}
+
+func (b *BasicAuthCredential) RequestBody(opts *GetAccessTokenOptions) any {
+	return nil
+}
+
+func (b *BasicAuthCredential) Auth(req *http.Request, token *AccessToken) error {
+	if b.Username == "" || b.Password == "" {
+		return errors.New("BasicAuthCredential: no username or password")
+	}
+	req.SetBasicAuth(b.Username, b.Password)
+	return nil
+}
+
+// TriggerTokenCredential authenticates with a pipeline trigger token. It is
+// accepted only by PipelineTriggersService.TriggerPipeline, which sends the
+// token in the request body, so pipelines can be triggered without any user
+// credential. Auth adds nothing to the request, keeping the token out of
+// URLs and logs.
+// docs: https://docs.gitlab.com/ee/ci/triggers/
+type TriggerTokenCredential struct {
+	Endpoint string `json:"endpoint" xml:"endpoint"`
+	Token    string `json:"token" xml:"token"`
+}
+
+func (t *TriggerTokenCredential) GetEndpoint() string {
+	return t.Endpoint
+}
+
+func (t *TriggerTokenCredential) RequestBody(opts *GetAccessTokenOptions) any {
+	return nil
+}
+
+func (t *TriggerTokenCredential) Auth(req *http.Request, token *AccessToken) error {
+	if t.Token == "" {
+		return errors.New("TriggerTokenCredential: no token")
+	}
+	return nil
+}
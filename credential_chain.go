@@ -0,0 +1,240 @@
+package gitlab
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultUnauthorizedCooldown = time.Hour
+	defaultRateLimitCooldown    = time.Minute
+)
+
+// ChainCredential wraps several credentials for the same GitLab instance
+// and fails over between them. Requests use the current member; when it is
+// answered with 401 Unauthorized or 429 Too Many Requests, the member is put
+// on cooldown and the request is retried with the next healthy one.
+//
+// Each member keeps its own OAuth token state, so OAuth and password
+// credentials can be mixed with access tokens. Members are identified by
+// their position in Credentials, so they need not be comparable, but the
+// slice must not be reordered once the chain is in use.
+type ChainCredential struct {
+	Credentials []Credential
+
+	// UnauthorizedCooldown is how long a member answered with 401 is skipped.
+	// Default: 1h.
+	UnauthorizedCooldown time.Duration
+	// RateLimitCooldown is how long a member answered with 429 is skipped
+	// when the response has no Retry-After header. Default: 1m.
+	RateLimitCooldown time.Duration
+
+	mu    sync.Mutex
+	cur   int
+	until []time.Time
+}
+
+func NewChainCredential(credentials ...Credential) *ChainCredential {
+	return &ChainCredential{
+		Credentials:          credentials,
+		UnauthorizedCooldown: defaultUnauthorizedCooldown,
+		RateLimitCooldown:    defaultRateLimitCooldown,
+	}
+}
+
+// GetEndpoint returns the endpoint of the first member. All members are
+// expected to point at the same instance.
+func (c *ChainCredential) GetEndpoint() string {
+	if len(c.Credentials) == 0 {
+		return ""
+	}
+	return c.Credentials[0].GetEndpoint()
+}
+
+func (c *ChainCredential) RequestBody(opts *GetAccessTokenOptions) any {
+	if cur := c.Current(); cur != nil {
+		return cur.RequestBody(opts)
+	}
+	return nil
+}
+
+func (c *ChainCredential) Auth(req *http.Request, token *AccessToken) error {
+	cur := c.Current()
+	if cur == nil {
+		return ErrCredential
+	}
+	return cur.Auth(req, token)
+}
+
+// Current returns the member in use. If every member is cooling down, the
+// one that recovers first is returned.
+func (c *ChainCredential) Current() Credential {
+	_, cur := c.current()
+	return cur
+}
+
+// current returns the member in use along with its index.
+func (c *ChainCredential) current() (int, Credential) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := len(c.Credentials)
+	if n == 0 {
+		return -1, nil
+	}
+
+	now := time.Now()
+	best := -1
+	for i := 0; i < n; i++ {
+		idx := (c.cur + i) % n
+		until := c.untilAt(idx)
+		if !now.Before(until) {
+			c.cur = idx
+			return idx, c.Credentials[idx]
+		}
+		if best < 0 || until.Before(c.untilAt(best)) {
+			best = idx
+		}
+	}
+	c.cur = best
+	return best, c.Credentials[best]
+}
+
+// untilAt returns the end of the cooldown of the member at idx. It must be
+// called with mu held.
+func (c *ChainCredential) untilAt(idx int) time.Time {
+	if idx >= 0 && idx < len(c.until) {
+		return c.until[idx]
+	}
+	return time.Time{}
+}
+
+// indexOf returns the index of credential among the members, or -1.
+// Members whose dynamic type is not comparable can't be looked up by value.
+func (c *ChainCredential) indexOf(credential Credential) int {
+	if credential == nil || !reflect.TypeOf(credential).Comparable() {
+		return -1
+	}
+	for i, member := range c.Credentials {
+		if reflect.TypeOf(member) == reflect.TypeOf(credential) && member == credential {
+			return i
+		}
+	}
+	return -1
+}
+
+// Healthy reports whether the member is not cooling down. Members are
+// looked up by value, so a member of a non-comparable type is never found
+// and reported healthy; use HealthyAt for those.
+func (c *ChainCredential) Healthy(credential Credential) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	idx := c.indexOf(credential)
+	return idx < 0 || !time.Now().Before(c.untilAt(idx))
+}
+
+// HealthyAt reports whether the member at index i of Credentials is not
+// cooling down.
+func (c *ChainCredential) HealthyAt(i int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return !time.Now().Before(c.untilAt(i))
+}
+
+// MarkUnhealthy puts the member on cooldown for d and moves on to the next.
+// It reports false if credential is not found among the members, which is
+// always the case for a non-comparable type; use MarkUnhealthyAt for those.
+func (c *ChainCredential) MarkUnhealthy(credential Credential, d time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	idx := c.indexOf(credential)
+	if idx < 0 {
+		return false
+	}
+	c.markUnhealthy(idx, d)
+	return true
+}
+
+// MarkUnhealthyAt puts the member at index i of Credentials on cooldown for
+// d and moves on to the next. It reports false if i is out of range.
+func (c *ChainCredential) MarkUnhealthyAt(i int, d time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if i < 0 || i >= len(c.Credentials) {
+		return false
+	}
+	c.markUnhealthy(i, d)
+	return true
+}
+
+// markUnhealthy puts the member at idx on cooldown. It must be called with
+// mu held.
+func (c *ChainCredential) markUnhealthy(idx int, d time.Duration) {
+	n := len(c.Credentials)
+	if idx < 0 || idx >= n {
+		return
+	}
+	if len(c.until) < n {
+		until := make([]time.Time, n)
+		copy(until, c.until)
+		c.until = until
+	}
+	c.until[idx] = time.Now().Add(d)
+	if c.cur%n == idx {
+		c.cur = (c.cur + 1) % n
+	}
+}
+
+// failover marks the member unhealthy if err warrants switching to another
+// one and reports whether it did.
+func (c *ChainCredential) failover(idx int, err error, retryAfter time.Duration) bool {
+	code, ok := StatusForErr(err)
+	if !ok {
+		return false
+	}
+	switch code {
+	case http.StatusUnauthorized:
+		d := c.UnauthorizedCooldown
+		if d <= 0 {
+			d = defaultUnauthorizedCooldown
+		}
+		c.mu.Lock()
+		c.markUnhealthy(idx, d)
+		c.mu.Unlock()
+	case http.StatusTooManyRequests:
+		d := retryAfter
+		if d <= 0 {
+			d = c.RateLimitCooldown
+		}
+		if d <= 0 {
+			d = defaultRateLimitCooldown
+		}
+		c.mu.Lock()
+		c.markUnhealthy(idx, d)
+		c.mu.Unlock()
+	default:
+		return false
+	}
+	return true
+}
+
+// parseRetryAfter reads the Retry-After header in seconds or as an HTTP date.
+func parseRetryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
@@ -0,0 +1,118 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChainCredential_Failover(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("PRIVATE-TOKEN")
+		seen = append(seen, token)
+		w.Header().Set("Content-Type", "application/json")
+		switch token {
+		case "revoked":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"401 Unauthorized"}`))
+		case "limited":
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"429 Too Many Requests"}`))
+		default:
+			_, _ = w.Write([]byte(`{"version":"17.0.0","revision":"abc"}`))
+		}
+	}))
+	defer srv.Close()
+
+	revoked := &TokenCredential{Endpoint: srv.URL, TokenType: PrivateToken, AccessToken: "revoked"}
+	limited := &TokenCredential{Endpoint: srv.URL, TokenType: PrivateToken, AccessToken: "limited"}
+	healthy := &TokenCredential{Endpoint: srv.URL, TokenType: PrivateToken, AccessToken: "healthy"}
+	chain := NewChainCredential(revoked, limited, healthy)
+	client := NewClient(chain)
+
+	v, err := client.Version.GetVersion(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != "17.0.0" {
+		t.Errorf("unexpected version: %+v", v)
+	}
+	if len(seen) != 3 || seen[0] != "revoked" || seen[1] != "limited" || seen[2] != "healthy" {
+		t.Errorf("unexpected attempts: %v", seen)
+	}
+	if chain.Healthy(revoked) || chain.Healthy(limited) || !chain.Healthy(healthy) {
+		t.Error("unexpected member health")
+	}
+
+	seen = nil
+	if _, err = client.Version.GetVersion(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 1 || seen[0] != "healthy" {
+		t.Errorf("cooling down members were retried: %v", seen)
+	}
+}
+
+// headerCredential is a Credential implemented on a non-comparable value type.
+type headerCredential struct {
+	endpoint string
+	headers  map[string]string
+}
+
+func (h headerCredential) GetEndpoint() string { return h.endpoint }
+
+func (h headerCredential) RequestBody(opts *GetAccessTokenOptions) any { return nil }
+
+func (h headerCredential) Auth(req *http.Request, token *AccessToken) error {
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	return nil
+}
+
+func TestChainCredential_NonComparable(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("PRIVATE-TOKEN")
+		seen = append(seen, token)
+		w.Header().Set("Content-Type", "application/json")
+		if token == "revoked" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"401 Unauthorized"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"version":"17.0.0","revision":"abc"}`))
+	}))
+	defer srv.Close()
+
+	revoked := headerCredential{endpoint: srv.URL, headers: map[string]string{"PRIVATE-TOKEN": "revoked"}}
+	healthy := headerCredential{endpoint: srv.URL, headers: map[string]string{"PRIVATE-TOKEN": "healthy"}}
+	chain := NewChainCredential(revoked, healthy)
+	client := NewClient(chain)
+
+	if _, err := client.Version.GetVersion(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 2 || seen[0] != "revoked" || seen[1] != "healthy" {
+		t.Errorf("unexpected attempts: %v", seen)
+	}
+	if !chain.Healthy(revoked) {
+		t.Error("non-comparable member should not be found by value")
+	}
+	if chain.MarkUnhealthy(healthy, time.Hour) {
+		t.Error("MarkUnhealthy found a non-comparable member by value")
+	}
+	if chain.HealthyAt(0) || !chain.HealthyAt(1) {
+		t.Errorf("unexpected member health: %v, %v", chain.HealthyAt(0), chain.HealthyAt(1))
+	}
+	if !chain.MarkUnhealthyAt(1, time.Hour) || chain.HealthyAt(1) {
+		t.Error("MarkUnhealthyAt did not put the member on cooldown")
+	}
+	if chain.MarkUnhealthyAt(2, time.Hour) {
+		t.Error("MarkUnhealthyAt accepted an out of range index")
+	}
+}
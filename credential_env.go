@@ -0,0 +1,237 @@
+package gitlab
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrCredentialNotFound = errors.New("no credential found in environment")
+)
+
+// CredentialFromEnv discovers a credential from the environment, in order:
+//
+//  1. GITLAB_TOKEN (or GITLAB_ACCESS_TOKEN), a personal, project or group access token.
+//  2. CI_JOB_TOKEN inside a GitLab CI/CD job (GITLAB_CI=true).
+//  3. The glab CLI config file (GLAB_CONFIG_DIR, XDG_CONFIG_HOME or ~/.config/glab-cli).
+//  4. ~/.netrc (or NETRC), using the password of the matching machine.
+//
+// The endpoint is taken from GITLAB_HOST, then CI_SERVER_URL, then the
+// source the token came from, and defaults to CloudEndpoint.
+func CredentialFromEnv() (Credential, error) {
+	host := os.Getenv("GITLAB_HOST")
+	if host == "" {
+		host = os.Getenv("CI_SERVER_URL")
+	}
+
+	for _, key := range []string{"GITLAB_TOKEN", "GITLAB_ACCESS_TOKEN"} {
+		if token := os.Getenv(key); token != "" {
+			return &TokenCredential{
+				Endpoint:    normalizeEndpoint(host),
+				TokenType:   PrivateToken,
+				AccessToken: token,
+			}, nil
+		}
+	}
+
+	if os.Getenv("GITLAB_CI") == "true" {
+		if token := os.Getenv("CI_JOB_TOKEN"); token != "" {
+			return &TokenCredential{
+				Endpoint:    normalizeEndpoint(os.Getenv("CI_SERVER_URL")),
+				TokenType:   JobToken,
+				AccessToken: token,
+			}, nil
+		}
+	}
+
+	if c, err := credentialFromGlabConfig(host); err != nil || c != nil {
+		return c, err
+	}
+
+	if c, err := credentialFromNetrc(host); err != nil || c != nil {
+		return c, err
+	}
+
+	return nil, ErrCredentialNotFound
+}
+
+// glabConfig is the subset of the glab CLI config.yml read by CredentialFromEnv.
+type glabConfig struct {
+	Host  string `yaml:"host"`
+	Hosts map[string]struct {
+		Token       string `yaml:"token"`
+		APIHost     string `yaml:"api_host"`
+		APIProtocol string `yaml:"api_protocol"`
+		IsOAuth2    string `yaml:"is_oauth2"`
+	} `yaml:"hosts"`
+}
+
+func glabConfigPath() string {
+	if dir := os.Getenv("GLAB_CONFIG_DIR"); dir != "" {
+		return filepath.Join(dir, "config.yml")
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "glab-cli", "config.yml")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "glab-cli", "config.yml")
+}
+
+func credentialFromGlabConfig(host string) (Credential, error) {
+	path := glabConfigPath()
+	if path == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var cfg glabConfig
+	if err = yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("glab config %s: %w", path, err)
+	}
+
+	name := hostName(host)
+	if name == "" {
+		name = cfg.Host
+	}
+	if name == "" {
+		name = hostName(CloudEndpoint)
+	}
+	h, ok := cfg.Hosts[name]
+	if !ok || h.Token == "" {
+		return nil, nil
+	}
+
+	protocol := h.APIProtocol
+	if protocol == "" {
+		protocol = "https"
+	}
+	apiHost := h.APIHost
+	if apiHost == "" {
+		apiHost = name
+	}
+	tokenType := PrivateToken
+	if h.IsOAuth2 == "true" {
+		tokenType = BearerToken
+	}
+	return &TokenCredential{
+		Endpoint:    protocol + "://" + apiHost,
+		TokenType:   tokenType,
+		AccessToken: h.Token,
+	}, nil
+}
+
+func netrcPath() string {
+	if p := os.Getenv("NETRC"); p != "" {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".netrc")
+}
+
+func credentialFromNetrc(host string) (Credential, error) {
+	path := netrcPath()
+	if path == "" {
+		return nil, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	endpoint := normalizeEndpoint(host)
+	name := hostName(endpoint)
+
+	var (
+		machine, password   string
+		defPassword         string
+		inDefault, inTarget bool
+	)
+	scanner := bufio.NewScanner(f)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		switch scanner.Text() {
+		case "machine":
+			if !scanner.Scan() {
+				break
+			}
+			machine = scanner.Text()
+			inTarget = machine == name
+			inDefault = false
+		case "default":
+			inDefault, inTarget = true, false
+		case "password":
+			if !scanner.Scan() {
+				break
+			}
+			if inTarget && password == "" {
+				password = scanner.Text()
+			} else if inDefault && defPassword == "" {
+				defPassword = scanner.Text()
+			}
+		case "macdef":
+			// macro definitions run until an empty line, which ScanWords
+			// cannot see; nothing after a macdef is reliable.
+			inTarget, inDefault = false, false
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if password == "" {
+		password = defPassword
+	}
+	if password == "" {
+		return nil, nil
+	}
+	return &TokenCredential{
+		Endpoint:    endpoint,
+		TokenType:   PrivateToken,
+		AccessToken: password,
+	}, nil
+}
+
+// normalizeEndpoint turns a bare host such as gitlab.example.com into an
+// https URL, falling back to CloudEndpoint.
+func normalizeEndpoint(host string) string {
+	host = strings.TrimRight(strings.TrimSpace(host), "/")
+	if host == "" {
+		return CloudEndpoint
+	}
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "https://" + host
+	}
+	return host
+}
+
+func hostName(endpoint string) string {
+	if i := strings.Index(endpoint, "://"); i >= 0 {
+		endpoint = endpoint[i+3:]
+	}
+	if i := strings.IndexByte(endpoint, '/'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	return endpoint
+}
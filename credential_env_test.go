@@ -0,0 +1,120 @@
+package gitlab
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func clearCredentialEnv(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	for _, key := range []string{"GITLAB_TOKEN", "GITLAB_ACCESS_TOKEN", "GITLAB_HOST", "GITLAB_CI",
+		"CI_JOB_TOKEN", "CI_SERVER_URL", "GLAB_CONFIG_DIR", "XDG_CONFIG_HOME", "NETRC"} {
+		t.Setenv(key, "")
+	}
+	t.Setenv("HOME", home)
+	return home
+}
+
+func TestCredentialFromEnv(t *testing.T) {
+	t.Run("token", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("GITLAB_TOKEN", "glpat-1")
+		t.Setenv("GITLAB_HOST", "gitlab.example.com")
+
+		c, err := CredentialFromEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tc := c.(*TokenCredential)
+		if tc.AccessToken != "glpat-1" || tc.TokenType != PrivateToken || tc.Endpoint != "https://gitlab.example.com" {
+			t.Errorf("unexpected credential: %+v", tc)
+		}
+	})
+
+	t.Run("job", func(t *testing.T) {
+		clearCredentialEnv(t)
+		t.Setenv("GITLAB_CI", "true")
+		t.Setenv("CI_JOB_TOKEN", "job-1")
+		t.Setenv("CI_SERVER_URL", "https://gitlab.example.com")
+
+		c, err := CredentialFromEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tc := c.(*TokenCredential)
+		if tc.AccessToken != "job-1" || tc.TokenType != JobToken || tc.Endpoint != "https://gitlab.example.com" {
+			t.Errorf("unexpected credential: %+v", tc)
+		}
+	})
+
+	t.Run("glab", func(t *testing.T) {
+		home := clearCredentialEnv(t)
+		dir := filepath.Join(home, ".config", "glab-cli")
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			t.Fatal(err)
+		}
+		cfg := "host: gitlab.example.com\nhosts:\n  gitlab.example.com:\n    token: oauth-1\n    api_protocol: https\n    is_oauth2: \"true\"\n"
+		if err := os.WriteFile(filepath.Join(dir, "config.yml"), []byte(cfg), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		c, err := CredentialFromEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tc := c.(*TokenCredential)
+		if tc.AccessToken != "oauth-1" || tc.TokenType != BearerToken || tc.Endpoint != "https://gitlab.example.com" {
+			t.Errorf("unexpected credential: %+v", tc)
+		}
+	})
+
+	t.Run("glab CI_SERVER_URL", func(t *testing.T) {
+		home := clearCredentialEnv(t)
+		t.Setenv("CI_SERVER_URL", "https://gitlab.example.com")
+		dir := filepath.Join(home, ".config", "glab-cli")
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			t.Fatal(err)
+		}
+		cfg := "host: gitlab.com\nhosts:\n  gitlab.com:\n    token: cloud-1\n  gitlab.example.com:\n    token: self-1\n"
+		if err := os.WriteFile(filepath.Join(dir, "config.yml"), []byte(cfg), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		c, err := CredentialFromEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tc := c.(*TokenCredential)
+		if tc.AccessToken != "self-1" || tc.Endpoint != "https://gitlab.example.com" {
+			t.Errorf("unexpected credential: %+v", tc)
+		}
+	})
+
+	t.Run("netrc", func(t *testing.T) {
+		home := clearCredentialEnv(t)
+		t.Setenv("GITLAB_HOST", "gitlab.example.com")
+		netrc := "machine github.com login x password gh\nmachine gitlab.example.com\n  login bot\n  password glpat-2\n"
+		if err := os.WriteFile(filepath.Join(home, ".netrc"), []byte(netrc), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		c, err := CredentialFromEnv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tc := c.(*TokenCredential)
+		if tc.AccessToken != "glpat-2" || tc.Endpoint != "https://gitlab.example.com" {
+			t.Errorf("unexpected credential: %+v", tc)
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		clearCredentialEnv(t)
+		if _, err := CredentialFromEnv(); !errors.Is(err, ErrCredentialNotFound) {
+			t.Errorf("got %v, want %v", err, ErrCredentialNotFound)
+		}
+	})
+}
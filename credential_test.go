@@ -0,0 +1,112 @@
+package gitlab_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+)
+
+func TestCredentials_Auth(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential func(endpoint string) gitlab.Credential
+		check      func(t *testing.T, r *http.Request)
+	}{
+		{
+			name: "deploy token",
+			credential: func(endpoint string) gitlab.Credential {
+				return &gitlab.DeployTokenCredential{Endpoint: endpoint, Username: "gitlab+deploy-token-1", Token: "gldt-1"}
+			},
+			check: func(t *testing.T, r *http.Request) {
+				if user, pass, ok := r.BasicAuth(); !ok || user != "gitlab+deploy-token-1" || pass != "gldt-1" {
+					t.Errorf("got basic auth %q:%q, %v", user, pass, ok)
+				}
+			},
+		},
+		{
+			name: "basic auth",
+			credential: func(endpoint string) gitlab.Credential {
+				return &gitlab.BasicAuthCredential{Endpoint: endpoint, Username: "bot", Password: "glpat-1"}
+			},
+			check: func(t *testing.T, r *http.Request) {
+				if user, pass, ok := r.BasicAuth(); !ok || user != "bot" || pass != "glpat-1" {
+					t.Errorf("got basic auth %q:%q, %v", user, pass, ok)
+				}
+			},
+		},
+		{
+			name: "trigger token",
+			credential: func(endpoint string) gitlab.Credential {
+				return &gitlab.TriggerTokenCredential{Endpoint: endpoint, Token: "glptt-1"}
+			},
+			check: func(t *testing.T, r *http.Request) {
+				if r.URL.RawQuery != "" {
+					t.Errorf("token leaked into the URL: %q", r.URL.RawQuery)
+				}
+				if r.Header.Get("Authorization") != "" {
+					t.Error("unexpected Authorization header")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.check(t, r)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"version":"17.0.0"}`))
+			}))
+			defer srv.Close()
+
+			client := gitlab.NewClient(tt.credential(srv.URL))
+			if _, err := client.Version.GetVersion(context.Background()); err != nil {
+				t.Fatalf("Version.GetVersion returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCredentials_AuthMissingFields(t *testing.T) {
+	credentials := map[string]gitlab.Credential{
+		"deploy token":  &gitlab.DeployTokenCredential{Username: "gitlab+deploy-token-1"},
+		"basic auth":    &gitlab.BasicAuthCredential{Username: "bot"},
+		"basic no user": &gitlab.BasicAuthCredential{Password: "glpat-1"},
+		"trigger token": &gitlab.TriggerTokenCredential{},
+	}
+	for name, credential := range credentials {
+		req := httptest.NewRequest(http.MethodGet, "/api/v4/version", nil)
+		if err := credential.Auth(req, nil); err == nil {
+			t.Errorf("%s: expected an error for missing fields", name)
+		}
+	}
+}
+
+func TestTokenCredential_SetAccessToken(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("PRIVATE-TOKEN"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"17.0.0","revision":"abc"}`))
+	}))
+	defer srv.Close()
+
+	credential := &gitlab.TokenCredential{Endpoint: srv.URL, TokenType: gitlab.PrivateToken, AccessToken: "old"}
+	client := gitlab.NewClient(credential)
+
+	if _, err := client.Version.GetVersion(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	credential.SetAccessToken("new")
+	if _, err := client.Version.GetVersion(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 2 || seen[0] != "old" || seen[1] != "new" {
+		t.Errorf("unexpected tokens: %v", seen)
+	}
+	if credential.Token() != "new" {
+		t.Errorf("got token %q, want %q", credential.Token(), "new")
+	}
+}
@@ -0,0 +1,135 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidCron = errors.New("invalid cron expression")
+)
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // value names, indexed from min
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{
+		"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec",
+	}},
+	// 7 is Sunday as well as 0.
+	{name: "day of week", min: 0, max: 7, names: []string{
+		"sun", "mon", "tue", "wed", "thu", "fri", "sat",
+	}},
+}
+
+var cronMacros = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// ValidateCron checks a pipeline schedule cron expression the way GitLab
+// parses it: five fields (minute, hour, day of month, month, day of week)
+// made of *, values, ranges, steps and lists, with month and weekday names,
+// L for the last day of the month and weekday#n for the nth weekday, or one
+// of the @yearly, @monthly, @weekly, @daily and @hourly macros.
+//
+// Errors wrap ErrInvalidCron.
+func ValidateCron(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		if !cronMacros[strings.ToLower(expr)] {
+			return fmt.Errorf("%w %q: unknown macro", ErrInvalidCron, expr)
+		}
+		return nil
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("%w %q: want %d fields, got %d", ErrInvalidCron, expr, len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		for _, item := range strings.Split(field, ",") {
+			if err := cronFields[i].validate(strings.ToLower(item)); err != nil {
+				return fmt.Errorf("%w %q: %s %q: %s", ErrInvalidCron, expr, cronFields[i].name, item, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) validate(item string) error {
+	if item == "" {
+		return errors.New("empty value")
+	}
+
+	if base, step, ok := strings.Cut(item, "/"); ok {
+		n, err := strconv.Atoi(step)
+		if err != nil || n <= 0 {
+			return errors.New("step must be a positive number")
+		}
+		if n > f.max {
+			return errors.New("step out of range")
+		}
+		item = base
+	}
+
+	switch {
+	case item == "*":
+		return nil
+	case item == "l" && f.name == "day of month":
+		return nil
+	case strings.Contains(item, "#") && f.name == "day of week":
+		day, nth, _ := strings.Cut(item, "#")
+		if _, err := f.value(day); err != nil {
+			return err
+		}
+		if n, err := strconv.Atoi(nth); err != nil || n < 1 || n > 5 {
+			if nth != "l" && nth != "-1" {
+				return errors.New("nth weekday must be 1 to 5 or L")
+			}
+		}
+		return nil
+	}
+
+	lo, hi, isRange := strings.Cut(item, "-")
+	from, err := f.value(lo)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	to, err := f.value(hi)
+	if err != nil {
+		return err
+	}
+	if from > to {
+		return errors.New("range start after end")
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if s == name {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.New("not a number")
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("out of range %d-%d", f.min, f.max)
+	}
+	return n, nil
+}
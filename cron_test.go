@@ -0,0 +1,41 @@
+package gitlab
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateCron(t *testing.T) {
+	valid := []string{
+		"0 2 * * *",
+		"*/15 9-17 * * mon-fri",
+		"0 0 1,15 * *",
+		"30 4 L * *",
+		"0 8 * JAN,JUL 1#1",
+		"0 0 * * 7",
+		"@daily",
+	}
+	for _, expr := range valid {
+		if err := ValidateCron(expr); err != nil {
+			t.Errorf("ValidateCron(%q) returned error: %v", expr, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"0 2 * *",
+		"60 * * * *",
+		"0 24 * * *",
+		"0 0 0 * *",
+		"0 0 * 13 *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"0 0 * * fri#6",
+		"@every 5m",
+	}
+	for _, expr := range invalid {
+		if err := ValidateCron(expr); !errors.Is(err, ErrInvalidCron) {
+			t.Errorf("ValidateCron(%q) = %v, want %v", expr, err, ErrInvalidCron)
+		}
+	}
+}
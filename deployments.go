@@ -0,0 +1,222 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DeploymentsService handles communication with the deployment related
+// methods of the GitLab API. It accepts a CI_JOB_TOKEN (TokenCredential
+// with JobToken) in addition to the usual credentials.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/deployments.html
+type DeploymentsService service
+
+// Deployment represents a GitLab deployment.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/deployments.html
+type Deployment struct {
+	ID                   int                   `json:"id"`
+	IID                  int                   `json:"iid"`
+	Ref                  string                `json:"ref"`
+	SHA                  string                `json:"sha"`
+	Status               DeploymentStatusValue `json:"status"`
+	CreatedAt            *time.Time            `json:"created_at"`
+	UpdatedAt            *time.Time            `json:"updated_at"`
+	FinishedAt           *time.Time            `json:"finished_at"`
+	User                 *BasicUser            `json:"user"`
+	Environment          *Environment          `json:"environment"`
+	Deployable           *Job                  `json:"deployable"`
+	PendingApprovalCount int                   `json:"pending_approval_count"`
+	Approvals            []*DeploymentApproval `json:"approvals"`
+}
+
+// DeploymentApproval represents an approval or rejection of a blocked
+// deployment. Status is approved or rejected.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+type DeploymentApproval struct {
+	User      *BasicUser `json:"user"`
+	Status    string     `json:"status"`
+	CreatedAt *time.Time `json:"created_at"`
+	Comment   string     `json:"comment"`
+}
+
+// ListProjectDeploymentsOptions represents the available
+// ListProjectDeployments() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#list-project-deployments
+type ListProjectDeploymentsOptions struct {
+	ListOptions `query:",inline"`
+
+	Environment    *string                `query:"environment,omitempty"`
+	Status         *DeploymentStatusValue `query:"status,omitempty"`
+	UpdatedAfter   *time.Time             `query:"updated_after,omitempty"`
+	UpdatedBefore  *time.Time             `query:"updated_before,omitempty"`
+	FinishedAfter  *time.Time             `query:"finished_after,omitempty"`
+	FinishedBefore *time.Time             `query:"finished_before,omitempty"`
+}
+
+// ListProjectDeployments gets a list of the deployments of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#list-project-deployments
+func (s *DeploymentsService) ListProjectDeployments(ctx context.Context, pid string, opts *ListProjectDeploymentsOptions) (*Records[Deployment], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/deployments", pid)
+	var v []*Deployment
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetDeployment gets a single deployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#get-a-specific-deployment
+func (s *DeploymentsService) GetDeployment(ctx context.Context, pid string, deployment int) (*Deployment, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/deployments/%d", pid, deployment)
+	var v Deployment
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateDeploymentOptions represents the available CreateDeployment()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#create-a-deployment
+type CreateDeploymentOptions struct {
+	Environment *string                `json:"environment,omitempty"`
+	Ref         *string                `json:"ref,omitempty"`
+	SHA         *string                `json:"sha,omitempty"`
+	Tag         *bool                  `json:"tag,omitempty"`
+	Status      *DeploymentStatusValue `json:"status,omitempty"`
+}
+
+// CreateDeployment records a deployment made outside of GitLab CI/CD.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#create-a-deployment
+func (s *DeploymentsService) CreateDeployment(ctx context.Context, pid string, opts *CreateDeploymentOptions) (*Deployment, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/deployments", pid)
+	var v Deployment
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateDeploymentOptions represents the available UpdateDeployment()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#update-a-deployment
+type UpdateDeploymentOptions struct {
+	Status *DeploymentStatusValue `json:"status,omitempty"`
+}
+
+// UpdateDeployment updates the status of a deployment created with
+// CreateDeployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#update-a-deployment
+func (s *DeploymentsService) UpdateDeployment(ctx context.Context, pid string, deployment int, opts *UpdateDeploymentOptions) (*Deployment, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/deployments/%d", pid, deployment)
+	var v Deployment
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteDeployment deletes a deployment that is not running and not the
+// last deployment of its environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#delete-a-specific-deployment
+func (s *DeploymentsService) DeleteDeployment(ctx context.Context, pid string, deployment int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/deployments/%d", pid, deployment)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeploymentApprovalOptions represents the available ApproveDeployment()
+// and RejectDeployment() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+type DeploymentApprovalOptions struct {
+	Comment *string `json:"comment,omitempty"`
+	// RepresentedAs is the name of the approval rule to approve for, when
+	// the user belongs to several.
+	RepresentedAs *string `json:"represented_as,omitempty"`
+}
+
+// ApproveDeployment approves a blocked deployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+func (s *DeploymentsService) ApproveDeployment(ctx context.Context, pid string, deployment int, opts *DeploymentApprovalOptions) (*DeploymentApproval, error) {
+	return s.setApproval(ctx, pid, deployment, "approved", opts)
+}
+
+// RejectDeployment rejects a blocked deployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+func (s *DeploymentsService) RejectDeployment(ctx context.Context, pid string, deployment int, opts *DeploymentApprovalOptions) (*DeploymentApproval, error) {
+	return s.setApproval(ctx, pid, deployment, "rejected", opts)
+}
+
+func (s *DeploymentsService) setApproval(ctx context.Context, pid string, deployment int, status string, opts *DeploymentApprovalOptions) (*DeploymentApproval, error) {
+	if opts == nil {
+		opts = &DeploymentApprovalOptions{}
+	}
+	body := struct {
+		Status string `json:"status"`
+		*DeploymentApprovalOptions
+	}{status, opts}
+
+	apiEndpoint := fmt.Sprintf("projects/%s/deployments/%d/approval", pid, deployment)
+	var v DeploymentApproval
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, body, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ListDeploymentMergeRequestsOptions represents the available
+// ListDeploymentMergeRequests() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#list-of-merge-requests-associated-with-a-deployment
+type ListDeploymentMergeRequestsOptions struct {
+	ListOptions `query:",inline"`
+
+	State *string `query:"state,omitempty"`
+}
+
+// ListDeploymentMergeRequests gets the merge requests shipped by a
+// deployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#list-of-merge-requests-associated-with-a-deployment
+func (s *DeploymentsService) ListDeploymentMergeRequests(ctx context.Context, pid string, deployment int, opts *ListDeploymentMergeRequestsOptions) (*Records[MergeRequest], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/deployments/%d/merge_requests", pid, deployment)
+	var v []*MergeRequest
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
@@ -0,0 +1,24 @@
+package gitlab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestDeploymentsService_ListProjectDeployments(t *testing.T) {
+	client := gitlab.NewClient(testTokenCredential, &gitlab.Options{Debug: true})
+
+	deployments, err := client.Deployments.ListProjectDeployments(context.Background(), "971", &gitlab.ListProjectDeploymentsOptions{
+		Environment: ptr.Ptr("production"),
+		Status:      ptr.Ptr(gitlab.DeploymentSuccess),
+	})
+	if err != nil {
+		t.Fatalf("Deployments.ListProjectDeployments returned error: %v", err)
+	}
+	for _, deployment := range deployments.Records {
+		t.Logf("deployment: %d %s %s\n", deployment.ID, deployment.Ref, deployment.Status)
+	}
+}
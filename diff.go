@@ -0,0 +1,161 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DiffLineType represents the kind of a line in a unified diff hunk.
+type DiffLineType string
+
+// List of available diff line types.
+const (
+	ContextDiffLine DiffLineType = "context"
+	AddedDiffLine   DiffLineType = "added"
+	RemovedDiffLine DiffLineType = "removed"
+)
+
+// DiffLine represents a single line of a diff hunk. OldLine is 0 for added
+// lines and NewLine is 0 for removed lines; context lines have both.
+type DiffLine struct {
+	Type           DiffLineType
+	Content        string
+	OldLine        int
+	NewLine        int
+	NoNewlineAtEOF bool
+}
+
+// DiffHunk represents a hunk of a unified diff, as introduced by its
+// "@@ -OldStart,OldLines +NewStart,NewLines @@ Section" header.
+type DiffHunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Section  string
+	Lines    []*DiffLine
+}
+
+// ErrInvalidDiff is returned by ParseDiff for malformed unified diffs.
+var ErrInvalidDiff = errors.New("gitlab: invalid unified diff")
+
+// ParseDiff parses the hunks of a unified diff, such as MergeRequestDiff.Diff.
+// File headers ("diff --git", "---", "+++", "index" and the like) outside
+// hunks are skipped, so the output of GetMergeRequestRawDiffs and plain
+// "diff -u" output covering several files parse as well. A hunk ends once
+// it holds the number of lines its header declares.
+func ParseDiff(diff string) ([]*DiffHunk, error) {
+	var (
+		hunks            []*DiffHunk
+		hunk             *DiffHunk
+		last             *DiffLine
+		oldLine, newLine int
+		oldLeft, newLeft int
+	)
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "\\") {
+			// "\ No newline at end of file" applies to the previous line.
+			if last != nil {
+				last.NoNewlineAtEOF = true
+			}
+			continue
+		}
+		last = nil
+		if hunk == nil {
+			if !strings.HasPrefix(line, "@@") {
+				continue
+			}
+			h, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidDiff, i+1, err)
+			}
+			hunk, oldLine, newLine = h, h.OldStart, h.NewStart
+			oldLeft, newLeft = h.OldLines, h.NewLines
+			hunks = append(hunks, hunk)
+			if oldLeft == 0 && newLeft == 0 {
+				hunk = nil
+			}
+			continue
+		}
+
+		var dl *DiffLine
+		switch {
+		case line == "":
+			// Some tools strip the leading space of empty context lines.
+			dl = &DiffLine{Type: ContextDiffLine, OldLine: oldLine, NewLine: newLine}
+		case line[0] == ' ':
+			dl = &DiffLine{Type: ContextDiffLine, Content: line[1:], OldLine: oldLine, NewLine: newLine}
+		case line[0] == '+':
+			dl = &DiffLine{Type: AddedDiffLine, Content: line[1:], NewLine: newLine}
+		case line[0] == '-':
+			dl = &DiffLine{Type: RemovedDiffLine, Content: line[1:], OldLine: oldLine}
+		default:
+			// A hunk cut short ends at anything else.
+			hunk = nil
+			continue
+		}
+		if dl.Type != AddedDiffLine {
+			oldLine++
+			oldLeft--
+		}
+		if dl.Type != RemovedDiffLine {
+			newLine++
+			newLeft--
+		}
+		if oldLeft < 0 || newLeft < 0 {
+			return nil, fmt.Errorf("%w: line %d: more lines than the hunk header declares", ErrInvalidDiff, i+1)
+		}
+		hunk.Lines = append(hunk.Lines, dl)
+		last = dl
+		if oldLeft == 0 && newLeft == 0 {
+			hunk = nil
+		}
+	}
+	return hunks, nil
+}
+
+// Hunks parses the diff of the file. See ParseDiff.
+func (d *MergeRequestDiff) Hunks() ([]*DiffHunk, error) {
+	return ParseDiff(d.Diff)
+}
+
+// parseHunkHeader parses "@@ -l[,s] +l[,s] @@[ section]".
+func parseHunkHeader(line string) (*DiffHunk, error) {
+	rest := strings.TrimPrefix(line, "@@ ")
+	end := strings.Index(rest, " @@")
+	if end < 0 {
+		return nil, fmt.Errorf("malformed hunk header %q", line)
+	}
+	ranges := strings.Fields(rest[:end])
+	if len(ranges) != 2 || !strings.HasPrefix(ranges[0], "-") || !strings.HasPrefix(ranges[1], "+") {
+		return nil, fmt.Errorf("malformed hunk header %q", line)
+	}
+
+	h := &DiffHunk{Section: strings.TrimPrefix(rest[end+len(" @@"):], " ")}
+	var err error
+	if h.OldStart, h.OldLines, err = parseHunkRange(ranges[0][1:]); err != nil {
+		return nil, err
+	}
+	if h.NewStart, h.NewLines, err = parseHunkRange(ranges[1][1:]); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// parseHunkRange parses "start[,count]"; count defaults to 1.
+func parseHunkRange(s string) (start, count int, err error) {
+	count = 1
+	startStr, countStr, found := strings.Cut(s, ",")
+	if start, err = strconv.Atoi(startStr); err != nil {
+		return 0, 0, fmt.Errorf("malformed hunk range %q", s)
+	}
+	if found {
+		if count, err = strconv.Atoi(countStr); err != nil {
+			return 0, 0, fmt.Errorf("malformed hunk range %q", s)
+		}
+	}
+	return start, count, nil
+}
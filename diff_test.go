@@ -0,0 +1,108 @@
+package gitlab
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDiff(t *testing.T) {
+	diff := "--- a/main.go\n+++ b/main.go\n" +
+		"@@ -1,4 +1,5 @@ package main\n" +
+		" import \"fmt\"\n" +
+		"-func a() {}\n" +
+		"+func a() int { return 1 }\n" +
+		"+func b() {}\n" +
+		" \n" +
+		" func main() {}\n" +
+		"@@ -10 +11,0 @@\n" +
+		"-// end\n" +
+		"\\ No newline at end of file\n"
+
+	hunks, err := (&MergeRequestDiff{Diff: diff}).Hunks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hunks) != 2 {
+		t.Fatalf("got %d hunks, want 2", len(hunks))
+	}
+
+	h := hunks[0]
+	if h.OldStart != 1 || h.OldLines != 4 || h.NewStart != 1 || h.NewLines != 5 || h.Section != "package main" {
+		t.Errorf("unexpected header: %+v", h)
+	}
+	want := []DiffLine{
+		{Type: ContextDiffLine, Content: `import "fmt"`, OldLine: 1, NewLine: 1},
+		{Type: RemovedDiffLine, Content: "func a() {}", OldLine: 2},
+		{Type: AddedDiffLine, Content: "func a() int { return 1 }", NewLine: 2},
+		{Type: AddedDiffLine, Content: "func b() {}", NewLine: 3},
+		{Type: ContextDiffLine, Content: "", OldLine: 3, NewLine: 4},
+		{Type: ContextDiffLine, Content: "func main() {}", OldLine: 4, NewLine: 5},
+	}
+	if len(h.Lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(h.Lines), len(want))
+	}
+	for i, l := range h.Lines {
+		if *l != want[i] {
+			t.Errorf("line %d: got %+v, want %+v", i, *l, want[i])
+		}
+	}
+
+	h = hunks[1]
+	if h.OldStart != 10 || h.OldLines != 1 || h.NewStart != 11 || h.NewLines != 0 {
+		t.Errorf("unexpected header: %+v", h)
+	}
+	if len(h.Lines) != 1 || h.Lines[0].OldLine != 10 || !h.Lines[0].NoNewlineAtEOF {
+		t.Errorf("unexpected lines: %+v", h.Lines)
+	}
+}
+
+func TestParseDiff_Invalid(t *testing.T) {
+	for _, diff := range []string{
+		"@@ -a,1 +1 @@\n+x\n",
+		"@@ -1,1 +1,2 @@\n-x\n-y\n+z\n",
+	} {
+		if _, err := ParseDiff(diff); !errors.Is(err, ErrInvalidDiff) {
+			t.Errorf("ParseDiff(%q) = %v, want ErrInvalidDiff", diff, err)
+		}
+	}
+}
+
+func TestParseDiff_MultipleFiles(t *testing.T) {
+	diff := "--- a/schema.sql\n+++ b/schema.sql\n" +
+		"@@ -1,2 +1,1 @@\n" +
+		"--- drop me\n" +
+		" CREATE TABLE t (id int);\n" +
+		"--- a/main.go\n+++ b/main.go\n" +
+		"@@ -5,1 +5,2 @@\n" +
+		" func main() {}\n" +
+		"+// end\n"
+
+	hunks, err := ParseDiff(diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hunks) != 2 {
+		t.Fatalf("got %d hunks, want 2", len(hunks))
+	}
+
+	want := [][]DiffLine{
+		{
+			{Type: RemovedDiffLine, Content: "-- drop me", OldLine: 1},
+			{Type: ContextDiffLine, Content: "CREATE TABLE t (id int);", OldLine: 2, NewLine: 1},
+		},
+		{
+			{Type: ContextDiffLine, Content: "func main() {}", OldLine: 5, NewLine: 5},
+			{Type: AddedDiffLine, Content: "// end", NewLine: 6},
+		},
+	}
+	for i, h := range hunks {
+		if len(h.Lines) != len(want[i]) {
+			t.Fatalf("hunk %d: got %d lines, want %d: %+v", i, len(h.Lines), len(want[i]), h.Lines)
+		}
+		for j, l := range h.Lines {
+			if *l != want[i][j] {
+				t.Errorf("hunk %d line %d: got %+v, want %+v", i, j, *l, want[i][j])
+			}
+		}
+	}
+}
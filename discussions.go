@@ -0,0 +1,169 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DiscussionsService handles communication with the discussions (threads)
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/discussions.html
+type DiscussionsService service
+
+// Discussion represents a thread of notes. Standalone comments are returned
+// as discussions with IndividualNote set and a single note.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/discussions.html
+type Discussion struct {
+	ID             string  `json:"id"`
+	IndividualNote bool    `json:"individual_note"`
+	Notes          []*Note `json:"notes"`
+}
+
+// ListMergeRequestDiscussions gets a list of all discussions of a merge
+// request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#list-project-merge-request-discussion-items
+func (s *DiscussionsService) ListMergeRequestDiscussions(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[Discussion], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/discussions", pid, iid)
+	var v []*Discussion
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestDiscussion gets a single discussion of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#get-single-merge-request-discussion-item
+func (s *DiscussionsService) GetMergeRequestDiscussion(ctx context.Context, pid string, iid int, discussion string) (*Discussion, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s", pid, iid, discussion)
+	var v Discussion
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateMergeRequestDiscussionOptions represents the available
+// CreateMergeRequestDiscussion() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#create-new-merge-request-thread
+type CreateMergeRequestDiscussionOptions struct {
+	Body      *string    `json:"body,omitempty"`
+	CommitID  *string    `json:"commit_id,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Position  *Position  `json:"position,omitempty"`
+}
+
+// CreateMergeRequestDiscussion creates a new discussion on a merge request.
+// With a Position, the discussion is attached to a diff line; see
+// DiffRefs.PositionFor.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#create-new-merge-request-thread
+func (s *DiscussionsService) CreateMergeRequestDiscussion(ctx context.Context, pid string, iid int, opts *CreateMergeRequestDiscussionOptions) (*Discussion, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/discussions", pid, iid)
+	var v Discussion
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ResolveMergeRequestDiscussion resolves a discussion of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#resolve-a-merge-request-thread
+func (s *DiscussionsService) ResolveMergeRequestDiscussion(ctx context.Context, pid string, iid int, discussion string) (*Discussion, error) {
+	return s.setMergeRequestDiscussionResolved(ctx, pid, iid, discussion, true)
+}
+
+// UnresolveMergeRequestDiscussion reopens a resolved discussion of a merge
+// request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#resolve-a-merge-request-thread
+func (s *DiscussionsService) UnresolveMergeRequestDiscussion(ctx context.Context, pid string, iid int, discussion string) (*Discussion, error) {
+	return s.setMergeRequestDiscussionResolved(ctx, pid, iid, discussion, false)
+}
+
+func (s *DiscussionsService) setMergeRequestDiscussionResolved(ctx context.Context, pid string, iid int, discussion string, resolved bool) (*Discussion, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s", pid, iid, discussion)
+	opts := struct {
+		Resolved bool `json:"resolved"`
+	}{resolved}
+	var v Discussion
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// AddMergeRequestDiscussionNoteOptions represents the available
+// AddMergeRequestDiscussionNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#add-note-to-existing-merge-request-thread
+type AddMergeRequestDiscussionNoteOptions struct {
+	Body      *string    `json:"body,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// AddMergeRequestDiscussionNote replies to a discussion of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#add-note-to-existing-merge-request-thread
+func (s *DiscussionsService) AddMergeRequestDiscussionNote(ctx context.Context, pid string, iid int, discussion string, opts *AddMergeRequestDiscussionNoteOptions) (*Note, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s/notes", pid, iid, discussion)
+	var v Note
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateMergeRequestDiscussionNoteOptions represents the available
+// UpdateMergeRequestDiscussionNote() options. Set exactly one of Body and
+// Resolved.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#modify-an-existing-merge-request-thread-note
+type UpdateMergeRequestDiscussionNoteOptions struct {
+	Body     *string `json:"body,omitempty"`
+	Resolved *bool   `json:"resolved,omitempty"`
+}
+
+// UpdateMergeRequestDiscussionNote modifies or resolves a note of a
+// discussion of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#modify-an-existing-merge-request-thread-note
+func (s *DiscussionsService) UpdateMergeRequestDiscussionNote(ctx context.Context, pid string, iid int, discussion string, note int, opts *UpdateMergeRequestDiscussionNoteOptions) (*Note, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s/notes/%d", pid, iid, discussion, note)
+	var v Note
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteMergeRequestDiscussionNote deletes a note of a discussion of a merge
+// request. Deleting the last note deletes the discussion.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#delete-a-merge-request-thread-note
+func (s *DiscussionsService) DeleteMergeRequestDiscussionNote(ctx context.Context, pid string, iid int, discussion string, note int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s/notes/%d", pid, iid, discussion, note)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
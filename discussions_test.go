@@ -0,0 +1,89 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestDiscussionsService_CreateMergeRequestDiscussion(t *testing.T) {
+	refs := gitlab.DiffRefs{BaseSha: "base", StartSha: "start", HeadSha: "head"}
+	diff := &gitlab.MergeRequestDiff{
+		OldPath: "main.go",
+		NewPath: "main.go",
+		Diff:    "@@ -1,2 +1,3 @@\n package main\n+\n+func main() {}\n",
+	}
+	hunks, err := diff.Hunks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := hunks[0].Lines
+	pos, err := refs.RangePositionFor(diff, lines[0], lines[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/1/merge_requests/2/discussions" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Body     string           `json:"body"`
+			Position *gitlab.Position `json:"position"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		p := body.Position
+		if p == nil || p.HeadSHA != "head" || p.PositionType != gitlab.TextPositionType || p.OldLine != 0 || p.NewLine != 3 {
+			t.Errorf("unexpected position: %+v", p)
+		}
+		if p != nil && p.LineRange != nil {
+			start, end := p.LineRange.Start, p.LineRange.End
+			// sha1("main.go")
+			const code = "0607f785dfa3c3861b3239f6723eb276d8056461"
+			if start.Type != "old" || start.OldLine != 1 || start.NewLine != 1 || start.LineCode != code+"_1_1" {
+				t.Errorf("unexpected range start: %+v", start)
+			}
+			if end.Type != "new" || end.OldLine != 0 || end.NewLine != 3 || end.LineCode != code+"_0_3" {
+				t.Errorf("unexpected range end: %+v", end)
+			}
+		} else {
+			t.Error("missing line range")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"abc","notes":[{"id":9,"type":"DiffNote","body":"nit"}]}`))
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	d, err := client.Discussions.CreateMergeRequestDiscussion(context.Background(), "1", 2, &gitlab.CreateMergeRequestDiscussionOptions{
+		Body:     ptr.Ptr("nit"),
+		Position: pos,
+	})
+	if err != nil {
+		t.Fatalf("Discussions.CreateMergeRequestDiscussion returned error: %v", err)
+	}
+	if d.ID != "abc" || len(d.Notes) != 1 || d.Notes[0].Type != "DiffNote" {
+		t.Errorf("unexpected discussion: %+v", d)
+	}
+}
+
+func TestDiffRefs_RangePositionFor(t *testing.T) {
+	refs := gitlab.DiffRefs{BaseSha: "base", StartSha: "start", HeadSha: "head"}
+	diff := &gitlab.MergeRequestDiff{NewPath: "a"}
+	start := &gitlab.DiffLine{Type: gitlab.AddedDiffLine, NewLine: 5}
+	end := &gitlab.DiffLine{Type: gitlab.AddedDiffLine, NewLine: 3}
+	if _, err := refs.RangePositionFor(diff, start, end); err == nil {
+		t.Error("expected an error for a reversed range")
+	}
+	if _, err := (gitlab.DiffRefs{}).PositionFor(diff, start); err == nil {
+		t.Error("expected an error for empty diff refs")
+	}
+}
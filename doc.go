@@ -0,0 +1,29 @@
+// Package gitlab is a client for the GitLab REST API.
+//
+// # Credentials
+//
+// A Client authenticates every call with the Credential passed to NewClient
+// or SetCredential. Not every endpoint accepts every kind:
+//
+//   - TokenCredential with PrivateToken or BearerToken (personal, project and
+//     group access tokens), OAuthCredential and PasswordCredential are
+//     accepted by all services.
+//   - TokenCredential with JobToken (CI_JOB_TOKEN) is accepted only by the
+//     endpoints listed in the job token docs: here the job artifact methods
+//     of JobsService, DeploymentsService, EnvironmentsService,
+//     ReleasesService and PipelineTriggersService.TriggerPipeline (as
+//     TriggerPipelineOptions.Token), and outside the REST API the package
+//     registry.
+//   - DeployTokenCredential is accepted only by the package registry, the
+//     container registry and Git over HTTP; REST services reject it with 401.
+//   - BasicAuthCredential is accepted by the package registry and Git over
+//     HTTP; REST services do not accept passwords over Basic auth.
+//   - TriggerTokenCredential is accepted only by
+//     PipelineTriggersService.TriggerPipeline.
+//   - OAuthService.ListSigningKeys needs no credential at all.
+//
+// The services and methods listed above repeat this in their doc comments.
+//
+// GitLab docs: https://docs.gitlab.com/ee/api/rest/#authentication
+// https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html
+package gitlab
@@ -0,0 +1,144 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DraftNotesService handles communication with the draft notes related
+// methods of the GitLab API. Draft notes are pending review comments, only
+// visible to their author until published.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/draft_notes.html
+type DraftNotesService service
+
+// DraftNote represents a draft note of a merge request.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/draft_notes.html
+type DraftNote struct {
+	ID                int       `json:"id"`
+	AuthorID          int       `json:"author_id"`
+	MergeRequestID    int       `json:"merge_request_id"`
+	ResolveDiscussion bool      `json:"resolve_discussion"`
+	DiscussionID      string    `json:"discussion_id"`
+	Note              string    `json:"note"`
+	CommitID          string    `json:"commit_id"`
+	LineCode          string    `json:"line_code"`
+	Position          *Position `json:"position"`
+}
+
+// ListDraftNotes gets a list of the draft notes of the current user on a
+// merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#list-all-merge-request-draft-notes
+func (s *DraftNotesService) ListDraftNotes(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[DraftNote], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes", pid, iid)
+	var v []*DraftNote
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetDraftNote gets a single draft note of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#get-a-single-draft-note
+func (s *DraftNotesService) GetDraftNote(ctx context.Context, pid string, iid, note int) (*DraftNote, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d", pid, iid, note)
+	var v DraftNote
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateDraftNoteOptions represents the available CreateDraftNote()
+// options. Set InReplyToDiscussionID to reply to a discussion, or Position
+// to comment on a diff line (see DiffRefs.PositionFor).
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#create-a-draft-note
+type CreateDraftNoteOptions struct {
+	Note                  *string   `json:"note,omitempty"`
+	CommitID              *string   `json:"commit_id,omitempty"`
+	InReplyToDiscussionID *string   `json:"in_reply_to_discussion_id,omitempty"`
+	ResolveDiscussion     *bool     `json:"resolve_discussion,omitempty"`
+	Position              *Position `json:"position,omitempty"`
+}
+
+// CreateDraftNote creates a draft note on a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#create-a-draft-note
+func (s *DraftNotesService) CreateDraftNote(ctx context.Context, pid string, iid int, opts *CreateDraftNoteOptions) (*DraftNote, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes", pid, iid)
+	var v DraftNote
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateDraftNoteOptions represents the available UpdateDraftNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#modify-existing-draft-note
+type UpdateDraftNoteOptions struct {
+	Note     *string   `json:"note,omitempty"`
+	Position *Position `json:"position,omitempty"`
+}
+
+// UpdateDraftNote modifies an existing draft note of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#modify-existing-draft-note
+func (s *DraftNotesService) UpdateDraftNote(ctx context.Context, pid string, iid, note int, opts *UpdateDraftNoteOptions) (*DraftNote, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d", pid, iid, note)
+	var v DraftNote
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteDraftNote deletes a draft note of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#delete-a-draft-note
+func (s *DraftNotesService) DeleteDraftNote(ctx context.Context, pid string, iid, note int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d", pid, iid, note)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishDraftNote publishes a single draft note of a merge request, which
+// notifies its participants on its own.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#publish-a-draft-note
+func (s *DraftNotesService) PublishDraftNote(ctx context.Context, pid string, iid, note int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d/publish", pid, iid, note)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAllDraftNotes publishes every draft note of the current user on a
+// merge request as one review, with a single notification.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#publish-all-pending-draft-notes
+func (s *DraftNotesService) PublishAllDraftNotes(ctx context.Context, pid string, iid int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/bulk_publish", pid, iid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package gitlab_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+)
+
+func TestDraftNotesService_PublishAllDraftNotes(t *testing.T) {
+	published := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/1/merge_requests/2/draft_notes/bulk_publish" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		published = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	if err := client.DraftNotes.PublishAllDraftNotes(context.Background(), "1", 2); err != nil {
+		t.Fatalf("DraftNotes.PublishAllDraftNotes returned error: %v", err)
+	}
+	if !published {
+		t.Error("draft notes were not published")
+	}
+}
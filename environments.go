@@ -0,0 +1,194 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// EnvironmentsService handles communication with the environment related
+// methods of the GitLab API. It accepts a CI_JOB_TOKEN (TokenCredential
+// with JobToken) in addition to the usual credentials.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/environments.html
+type EnvironmentsService service
+
+// Environment represents a GitLab environment. State is one of available,
+// stopping or stopped; Tier is one of production, staging, testing,
+// development or other.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/environments.html
+type Environment struct {
+	ID                  int         `json:"id"`
+	Name                string      `json:"name"`
+	Slug                string      `json:"slug"`
+	Description         string      `json:"description"`
+	ExternalURL         string      `json:"external_url"`
+	State               string      `json:"state"`
+	Tier                string      `json:"tier"`
+	CreatedAt           *time.Time  `json:"created_at"`
+	UpdatedAt           *time.Time  `json:"updated_at"`
+	AutoStopAt          *time.Time  `json:"auto_stop_at"`
+	AutoStopSetting     string      `json:"auto_stop_setting"`
+	KubernetesNamespace string      `json:"kubernetes_namespace"`
+	FluxResourcePath    string      `json:"flux_resource_path"`
+	LastDeployment      *Deployment `json:"last_deployment"`
+}
+
+// ListEnvironmentsOptions represents the available ListEnvironments()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#list-environments
+type ListEnvironmentsOptions struct {
+	ListOptions `query:",inline"`
+
+	Name   *string `query:"name,omitempty"`
+	Search *string `query:"search,omitempty"`
+	States *string `query:"states,omitempty"`
+}
+
+// ListEnvironments gets a list of the environments of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#list-environments
+func (s *EnvironmentsService) ListEnvironments(ctx context.Context, pid string, opts *ListEnvironmentsOptions) (*Records[Environment], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/environments", pid)
+	var v []*Environment
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetEnvironment gets a single environment, with its last deployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#get-a-specific-environment
+func (s *EnvironmentsService) GetEnvironment(ctx context.Context, pid string, environment int) (*Environment, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/environments/%d", pid, environment)
+	var v Environment
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateEnvironmentOptions represents the available CreateEnvironment()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#create-a-new-environment
+type CreateEnvironmentOptions struct {
+	Name                *string `json:"name,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	ExternalURL         *string `json:"external_url,omitempty"`
+	Tier                *string `json:"tier,omitempty"`
+	ClusterAgentID      *int    `json:"cluster_agent_id,omitempty"`
+	KubernetesNamespace *string `json:"kubernetes_namespace,omitempty"`
+	FluxResourcePath    *string `json:"flux_resource_path,omitempty"`
+	AutoStopSetting     *string `json:"auto_stop_setting,omitempty"`
+}
+
+// CreateEnvironment creates an environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#create-a-new-environment
+func (s *EnvironmentsService) CreateEnvironment(ctx context.Context, pid string, opts *CreateEnvironmentOptions) (*Environment, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/environments", pid)
+	var v Environment
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateEnvironmentOptions represents the available UpdateEnvironment()
+// options. The name of an environment cannot be changed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#update-an-existing-environment
+type UpdateEnvironmentOptions struct {
+	Description         *string `json:"description,omitempty"`
+	ExternalURL         *string `json:"external_url,omitempty"`
+	Tier                *string `json:"tier,omitempty"`
+	ClusterAgentID      *int    `json:"cluster_agent_id,omitempty"`
+	KubernetesNamespace *string `json:"kubernetes_namespace,omitempty"`
+	FluxResourcePath    *string `json:"flux_resource_path,omitempty"`
+	AutoStopSetting     *string `json:"auto_stop_setting,omitempty"`
+}
+
+// UpdateEnvironment updates an environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#update-an-existing-environment
+func (s *EnvironmentsService) UpdateEnvironment(ctx context.Context, pid string, environment int, opts *UpdateEnvironmentOptions) (*Environment, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/environments/%d", pid, environment)
+	var v Environment
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteEnvironment deletes a stopped environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#delete-an-environment
+func (s *EnvironmentsService) DeleteEnvironment(ctx context.Context, pid string, environment int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/environments/%d", pid, environment)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StopEnvironmentOptions represents the available StopEnvironment()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-an-environment
+type StopEnvironmentOptions struct {
+	// Force stops the environment without running its on_stop actions.
+	Force *bool `json:"force,omitempty"`
+}
+
+// StopEnvironment stops an environment, running its on_stop job if any.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-an-environment
+func (s *EnvironmentsService) StopEnvironment(ctx context.Context, pid string, environment int, opts *StopEnvironmentOptions) (*Environment, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/environments/%d/stop", pid, environment)
+	var v Environment
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// StopStaleEnvironmentsOptions represents the available
+// StopStaleEnvironments() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-stale-environments
+type StopStaleEnvironmentsOptions struct {
+	// Before stops environments last modified or deployed to before this
+	// time. It must be between 10 years and 1 week ago.
+	Before *time.Time `json:"before,omitempty"`
+}
+
+// StopStaleEnvironments stops, in the background, every environment last
+// modified or deployed to before a date. Protected environments are
+// skipped.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-stale-environments
+func (s *EnvironmentsService) StopStaleEnvironments(ctx context.Context, pid string, opts *StopStaleEnvironmentsOptions) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/environments/stop_stale", pid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -1,12 +1,15 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/nexuer/ghttp"
@@ -38,27 +41,53 @@ type Options struct {
 }
 
 type Client struct {
-	cc         *ghttp.Client
+	cc *ghttp.Client
+	// streamCC serves responses whose body is handed to the caller. It has
+	// no Timeout, since ghttp cancels the request once Do returns, and drops
+	// token headers on redirects to other hosts.
+	streamCC   *ghttp.Client
 	apiVersion APIVersion
 
 	common service
 
 	OAuth *OAuthService
 	//
-	Branches        *BranchesService
-	Commits         *CommitsService
-	MergeRequests   *MergeRequestsService
-	Tags            *TagsService
-	Users           *UsersService
-	Projects        *ProjectsService
-	Version         *VersionService
-	Metadata        *MetadataService
-	Releases        *ReleasesService
-	RepositoryFiles *RepositoryFilesService
-	Milestones      *MilestonesService
-	Namespaces      *NamespacesService
-	Groups          *GroupsService
-	Members         *MembersService
+	Branches              *BranchesService
+	Commits               *CommitsService
+	MergeRequests         *MergeRequestsService
+	Tags                  *TagsService
+	Users                 *UsersService
+	Projects              *ProjectsService
+	Version               *VersionService
+	Metadata              *MetadataService
+	Releases              *ReleasesService
+	RepositoryFiles       *RepositoryFilesService
+	Milestones            *MilestonesService
+	Namespaces            *NamespacesService
+	Groups                *GroupsService
+	Members               *MembersService
+	Pipelines             *PipelinesService
+	Jobs                  *JobsService
+	PipelineSchedules     *PipelineSchedulesService
+	PipelineTriggers      *PipelineTriggersService
+	ProjectVariables      *ProjectVariablesService
+	GroupVariables        *GroupVariablesService
+	InstanceVariables     *InstanceVariablesService
+	CILint                *CILintService
+	Runners               *RunnersService
+	Environments          *EnvironmentsService
+	Deployments           *DeploymentsService
+	Notes                 *NotesService
+	Discussions           *DiscussionsService
+	MergeRequestApprovals *MergeRequestApprovalsService
+	MergeTrains           *MergeTrainsService
+	DraftNotes            *DraftNotesService
+	Issues                *IssuesService
+	IssueLinks            *IssueLinksService
+
+	PersonalAccessTokens *PersonalAccessTokensService
+	ProjectAccessTokens  *ProjectAccessTokensService
+	GroupAccessTokens    *GroupAccessTokensService
 }
 
 func NewClient(credential Credential, opts ...*Options) *Client {
@@ -75,6 +104,12 @@ func NewClient(credential Credential, opts ...*Options) *Client {
 	)
 
 	c.cc = ghttp.NewClient(clientOpts...)
+	// ghttp applies TLS and Proxy to http.DefaultTransport itself when
+	// building cc, so the wrapped transport picks them up.
+	c.streamCC = ghttp.NewClient(append(clientOpts[:len(clientOpts):len(clientOpts)],
+		ghttp.WithTimeout(0),
+		ghttp.WithTransport(&redirectTransport{base: http.DefaultTransport}),
+	)...)
 	c.common.client = c
 	c.OAuth = &OAuthService{client: c.common.client}
 
@@ -92,6 +127,27 @@ func NewClient(credential Credential, opts ...*Options) *Client {
 	c.Namespaces = (*NamespacesService)(&c.common)
 	c.Groups = (*GroupsService)(&c.common)
 	c.Members = (*MembersService)(&c.common)
+	c.Pipelines = (*PipelinesService)(&c.common)
+	c.Jobs = (*JobsService)(&c.common)
+	c.PipelineSchedules = (*PipelineSchedulesService)(&c.common)
+	c.PipelineTriggers = (*PipelineTriggersService)(&c.common)
+	c.ProjectVariables = (*ProjectVariablesService)(&c.common)
+	c.GroupVariables = (*GroupVariablesService)(&c.common)
+	c.InstanceVariables = (*InstanceVariablesService)(&c.common)
+	c.CILint = (*CILintService)(&c.common)
+	c.Runners = (*RunnersService)(&c.common)
+	c.Environments = (*EnvironmentsService)(&c.common)
+	c.Deployments = (*DeploymentsService)(&c.common)
+	c.Notes = (*NotesService)(&c.common)
+	c.Discussions = (*DiscussionsService)(&c.common)
+	c.MergeRequestApprovals = (*MergeRequestApprovalsService)(&c.common)
+	c.MergeTrains = (*MergeTrainsService)(&c.common)
+	c.DraftNotes = (*DraftNotesService)(&c.common)
+	c.Issues = (*IssuesService)(&c.common)
+	c.IssueLinks = (*IssueLinksService)(&c.common)
+	c.PersonalAccessTokens = (*PersonalAccessTokensService)(&c.common)
+	c.ProjectAccessTokens = (*ProjectAccessTokensService)(&c.common)
+	c.GroupAccessTokens = (*GroupAccessTokensService)(&c.common)
 
 	c.SetCredential(credential)
 	return c
@@ -149,9 +205,10 @@ func (c *Client) SetCredential(credential Credential) {
 	}
 
 	c.cc.SetEndpoint(endpoint)
+	c.streamCC.SetEndpoint(endpoint)
 
 	if c.OAuth != nil {
-		c.OAuth.credential = credential
+		c.OAuth.setCredential(credential)
 	}
 }
 
@@ -162,22 +219,79 @@ func (c *Client) API(path string, ver ...APIVersion) string {
 	return fmt.Sprintf("/api/%s/%s", c.apiVersion, path)
 }
 
-func (c *Client) InvokeWithCredential(ctx context.Context, method, path string, args any, reply any, fn ...ghttp.RequestFunc) (*http.Response, error) {
-	accessToken, err := c.OAuth.GetAccessToken(ctx)
+// webPath turns a web_url returned by the API into a path relative to the
+// endpoint, for web routes that have no API equivalent.
+func (c *Client) webPath(webURL string) (string, error) {
+	u, err := url.Parse(webURL)
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	endpoint := CloudEndpoint
+	if c.OAuth.credential != nil && c.OAuth.credential.GetEndpoint() != "" {
+		endpoint = c.OAuth.credential.GetEndpoint()
 	}
-	fns := make([]ghttp.RequestFunc, 1, len(fn)+1)
-	fns[0] = func(request *http.Request) error {
-		return c.OAuth.credential.Auth(request, accessToken)
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(u.EscapedPath(), strings.TrimRight(base.EscapedPath(), "/")), nil
+}
+
+func (c *Client) InvokeWithCredential(ctx context.Context, method, path string, args any, reply any, fn ...ghttp.RequestFunc) (*http.Response, error) {
+	return c.withCredential(ctx, func(auth ghttp.RequestFunc, after ghttp.ResponseFunc) (*http.Response, error) {
+		fns := make([]ghttp.RequestFunc, 1, len(fn)+1)
+		fns[0] = auth
+		fns = append(fns, fn...)
+		return c.invoke(ctx, method, c.API(path), args, reply, fns, after)
+	})
+}
+
+// withCredential runs call with the current credential. With a
+// ChainCredential, a 401 or 429 marks the member unhealthy and the call is
+// retried with the next one until every member has been tried.
+func (c *Client) withCredential(ctx context.Context, call func(auth ghttp.RequestFunc, after ghttp.ResponseFunc) (*http.Response, error)) (*http.Response, error) {
+	chain, _ := c.OAuth.credential.(*ChainCredential)
+	attempts := 1
+	if chain != nil && len(chain.Credentials) > 1 {
+		attempts = len(chain.Credentials)
+	}
+
+	for i := 0; ; i++ {
+		idx, credential := c.OAuth.current()
+		accessToken, err := c.OAuth.getAccessToken(ctx, idx, credential, &GetAccessTokenOptions{})
+		if err == nil {
+			var retryAfter time.Duration
+			var resp *http.Response
+			resp, err = call(func(request *http.Request) error {
+				return credential.Auth(request, accessToken)
+			}, func(response *http.Response) error {
+				retryAfter = parseRetryAfter(response)
+				return nil
+			})
+			if err == nil || chain == nil || i >= attempts-1 {
+				return resp, err
+			}
+			if !chain.failover(idx, err, retryAfter) {
+				return resp, err
+			}
+			continue
+		}
+		if chain == nil || i >= attempts-1 || !chain.failover(idx, err, 0) {
+			return nil, err
+		}
 	}
-	fns = append(fns, fn...)
-	return c.Invoke(ctx, method, c.API(path), args, reply, fns...)
 }
 
 func (c *Client) Invoke(ctx context.Context, method, path string, args any, reply any, fn ...ghttp.RequestFunc) (*http.Response, error) {
+	return c.invoke(ctx, method, path, args, reply, fn, nil)
+}
+
+func (c *Client) invoke(ctx context.Context, method, path string, args any, reply any, before []ghttp.RequestFunc, after ghttp.ResponseFunc) (*http.Response, error) {
 	opts := &ghttp.CallOptions{
-		BeforeHooks: fn,
+		BeforeHooks: before,
+	}
+	if after != nil {
+		opts.AfterHooks = []ghttp.ResponseFunc{after}
 	}
 	if method == http.MethodGet && args != nil {
 		opts.Query = args
@@ -187,6 +301,81 @@ func (c *Client) Invoke(ctx context.Context, method, path string, args any, repl
 	return c.cc.Invoke(ctx, method, path, args, reply, opts)
 }
 
+// StreamWithCredential is like InvokeWithCredential, but returns the response
+// with its body unread instead of decoding it, for plain text and binary
+// payloads. The caller must close the body.
+//
+// The client Timeout does not apply to streams; cancel ctx to abort them.
+func (c *Client) StreamWithCredential(ctx context.Context, method, path string, args any, fn ...ghttp.RequestFunc) (*http.Response, error) {
+	return c.streamWithCredential(ctx, method, c.API(path), args, fn...)
+}
+
+func (c *Client) streamWithCredential(ctx context.Context, method, path string, args any, fn ...ghttp.RequestFunc) (*http.Response, error) {
+	return c.withCredential(ctx, func(auth ghttp.RequestFunc, after ghttp.ResponseFunc) (*http.Response, error) {
+		fns := make([]ghttp.RequestFunc, 2, len(fn)+2)
+		fns[0] = func(request *http.Request) error {
+			request.Header.Set("Accept", "*/*")
+			return nil
+		}
+		fns[1] = auth
+		fns = append(fns, fn...)
+		return c.stream(ctx, method, path, args, fns, after)
+	})
+}
+
+func (c *Client) stream(ctx context.Context, method, path string, args any, before []ghttp.RequestFunc, after ghttp.ResponseFunc) (*http.Response, error) {
+	opts := &ghttp.CallOptions{
+		BeforeHooks: before,
+	}
+	if after != nil {
+		opts.AfterHooks = []ghttp.ResponseFunc{after}
+	}
+
+	var body io.Reader
+	if method == http.MethodGet {
+		opts.Query = args
+	} else if args != nil {
+		b, err := json.Marshal(args)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.streamCC.Do(req, opts)
+}
+
+// tokenHeaders are the credential headers Go's redirect policy does not know
+// about, so it would forward them to any host.
+var tokenHeaders = []string{"PRIVATE-TOKEN", "JOB-TOKEN"}
+
+// redirectTransport drops tokenHeaders from redirects that leave the host of
+// the original request, such as artifact downloads sent on to object storage.
+type redirectTransport struct {
+	base http.RoundTripper
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Response == nil {
+		return t.base.RoundTrip(req)
+	}
+	first := req
+	for first.Response != nil && first.Response.Request != nil {
+		first = first.Response.Request
+	}
+	if first.URL.Host != req.URL.Host {
+		req = req.Clone(req.Context())
+		for _, h := range tokenHeaders {
+			req.Header.Del(h)
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
 // Error data-validation-and-error-reporting + OAuth error
 // GitLab API docs: https://docs.gitlab.com/ee/api/rest/#data-validation-and-error-reporting
 // When an attribute is missing, you receive something like:
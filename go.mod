@@ -5,9 +5,7 @@ go 1.21
 require (
 	github.com/nexuer/ghttp v0.0.0-20250208080711-f19bb629968c
 	github.com/nexuer/utils v0.0.0-20250227055018-464d18c3ed03
+	gopkg.in/yaml.v3 v3.0.1
 )
 
-require (
-	google.golang.org/protobuf v1.36.3 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
-)
+require google.golang.org/protobuf v1.36.3 // indirect
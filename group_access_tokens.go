@@ -0,0 +1,132 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GroupAccessTokensService handles communication with the group access
+// tokens related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_access_tokens.html
+type GroupAccessTokensService service
+
+// GroupAccessToken represents a GitLab group access token.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_access_tokens.html
+type GroupAccessToken struct {
+	ID          int              `json:"id"`
+	UserID      int              `json:"user_id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Scopes      []string         `json:"scopes"`
+	CreatedAt   *time.Time       `json:"created_at"`
+	LastUsedAt  *time.Time       `json:"last_used_at"`
+	ExpiresAt   *Date            `json:"expires_at"`
+	Active      bool             `json:"active"`
+	Revoked     bool             `json:"revoked"`
+	Token       string           `json:"token,omitempty"`
+	AccessLevel AccessLevelValue `json:"access_level"`
+}
+
+// ListGroupAccessTokensOptions represents the available
+// ListGroupAccessTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#list-group-access-tokens
+type ListGroupAccessTokensOptions struct {
+	ListOptions `query:",inline"`
+
+	State *string `query:"state,omitempty"`
+}
+
+// ListGroupAccessTokens gets a list of all group access tokens in a
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#list-group-access-tokens
+func (s *GroupAccessTokensService) ListGroupAccessTokens(ctx context.Context, gid string, opts *ListGroupAccessTokensOptions) (*Records[GroupAccessToken], error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/access_tokens", gid)
+	var v []*GroupAccessToken
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetGroupAccessToken gets a single group access token in a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#get-a-group-access-token
+func (s *GroupAccessTokensService) GetGroupAccessToken(ctx context.Context, gid string, id int) (*GroupAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/access_tokens/%d", gid, id)
+	var v GroupAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateGroupAccessTokenOptions represents the available
+// CreateGroupAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#create-a-group-access-token
+type CreateGroupAccessTokenOptions struct {
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Scopes      *[]string         `json:"scopes,omitempty"`
+	AccessLevel *AccessLevelValue `json:"access_level,omitempty"`
+	ExpiresAt   *Date             `json:"expires_at,omitempty"`
+}
+
+// CreateGroupAccessToken creates a new group access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#create-a-group-access-token
+func (s *GroupAccessTokensService) CreateGroupAccessToken(ctx context.Context, gid string, opts *CreateGroupAccessTokenOptions) (*GroupAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/access_tokens", gid)
+	var v GroupAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RotateGroupAccessTokenOptions represents the available
+// RotateGroupAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#rotate-a-group-access-token
+type RotateGroupAccessTokenOptions struct {
+	ExpiresAt *Date `json:"expires_at,omitempty"`
+}
+
+// RotateGroupAccessToken revokes a group access token and returns a new
+// one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#rotate-a-group-access-token
+func (s *GroupAccessTokensService) RotateGroupAccessToken(ctx context.Context, gid string, id int, opts *RotateGroupAccessTokenOptions) (*GroupAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/access_tokens/%d/rotate", gid, id)
+	var v GroupAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RevokeGroupAccessToken revokes a group access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#revoke-a-group-access-token
+func (s *GroupAccessTokensService) RevokeGroupAccessToken(ctx context.Context, gid string, id int) error {
+	apiEndpoint := fmt.Sprintf("groups/%s/access_tokens/%d", gid, id)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GroupVariablesService handles communication with the group CI/CD
+// variables related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_level_variables.html
+type GroupVariablesService service
+
+// GroupVariable represents a group CI/CD variable. The Value of a hidden
+// variable is never returned by the API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_level_variables.html
+type GroupVariable struct {
+	Key              string            `json:"key"`
+	Value            string            `json:"value"`
+	VariableType     VariableTypeValue `json:"variable_type"`
+	Protected        bool              `json:"protected"`
+	Masked           bool              `json:"masked"`
+	Hidden           bool              `json:"hidden"`
+	Raw              bool              `json:"raw"`
+	EnvironmentScope string            `json:"environment_scope"`
+	Description      string            `json:"description"`
+}
+
+// ListGroupVariablesOptions represents the available ListGroupVariables()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#list-group-variables
+type ListGroupVariablesOptions struct {
+	ListOptions `query:",inline"`
+}
+
+// ListGroupVariables gets a list of the CI/CD variables of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#list-group-variables
+func (s *GroupVariablesService) ListGroupVariables(ctx context.Context, gid string, opts *ListGroupVariablesOptions) (*Records[GroupVariable], error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/variables", gid)
+	var v []*GroupVariable
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetGroupVariableOptions represents the available GetGroupVariable()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#show-variable-details
+type GetGroupVariableOptions struct {
+	Filter *VariableFilter
+}
+
+// GetGroupVariable gets a single CI/CD variable of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#show-variable-details
+func (s *GroupVariablesService) GetGroupVariable(ctx context.Context, gid, key string, opts *GetGroupVariableOptions) (*GroupVariable, error) {
+	var filter *VariableFilter
+	if opts != nil {
+		filter = opts.Filter
+	}
+	apiEndpoint := fmt.Sprintf("groups/%s/variables/%s", gid, key)
+	var v GroupVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v, filter.hook()); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateGroupVariableOptions represents the available CreateGroupVariable()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#create-variable
+type CreateGroupVariableOptions struct {
+	Key              *string            `json:"key,omitempty"`
+	Value            *string            `json:"value,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	EnvironmentScope *string            `json:"environment_scope,omitempty"`
+	Masked           *bool              `json:"masked,omitempty"`
+	MaskedAndHidden  *bool              `json:"masked_and_hidden,omitempty"`
+	Protected        *bool              `json:"protected,omitempty"`
+	Raw              *bool              `json:"raw,omitempty"`
+	VariableType     *VariableTypeValue `json:"variable_type,omitempty"`
+}
+
+// CreateGroupVariable creates a CI/CD variable of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#create-variable
+func (s *GroupVariablesService) CreateGroupVariable(ctx context.Context, gid string, opts *CreateGroupVariableOptions) (*GroupVariable, error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/variables", gid)
+	var v GroupVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateGroupVariableOptions represents the available UpdateGroupVariable()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#update-variable
+type UpdateGroupVariableOptions struct {
+	Value            *string            `json:"value,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	EnvironmentScope *string            `json:"environment_scope,omitempty"`
+	Masked           *bool              `json:"masked,omitempty"`
+	Protected        *bool              `json:"protected,omitempty"`
+	Raw              *bool              `json:"raw,omitempty"`
+	VariableType     *VariableTypeValue `json:"variable_type,omitempty"`
+	Filter           *VariableFilter    `json:"-"`
+}
+
+// UpdateGroupVariable updates a CI/CD variable of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#update-variable
+func (s *GroupVariablesService) UpdateGroupVariable(ctx context.Context, gid, key string, opts *UpdateGroupVariableOptions) (*GroupVariable, error) {
+	var filter *VariableFilter
+	if opts != nil {
+		filter = opts.Filter
+	}
+	apiEndpoint := fmt.Sprintf("groups/%s/variables/%s", gid, key)
+	var v GroupVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v, filter.hook()); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteGroupVariableOptions represents the available DeleteGroupVariable()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#remove-variable
+type DeleteGroupVariableOptions struct {
+	Filter *VariableFilter
+}
+
+// DeleteGroupVariable deletes a CI/CD variable of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#remove-variable
+func (s *GroupVariablesService) DeleteGroupVariable(ctx context.Context, gid, key string, opts *DeleteGroupVariableOptions) error {
+	var filter *VariableFilter
+	if opts != nil {
+		filter = opts.Filter
+	}
+	apiEndpoint := fmt.Sprintf("groups/%s/variables/%s", gid, key)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil, filter.hook()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrIDTokenMalformed = errors.New("id token: malformed")
+	ErrIDTokenSignature = errors.New("id token: invalid signature")
+	ErrIDTokenExpired   = errors.New("id token: expired")
+	ErrIDTokenNotYet    = errors.New("id token: not valid yet")
+	ErrIDTokenIssuer    = errors.New("id token: invalid issuer")
+	ErrIDTokenAudience  = errors.New("id token: invalid audience")
+	ErrIDTokenKeyID     = errors.New("id token: unknown key id")
+)
+
+// JSONWebKey represents a public key of the GitLab instance used to sign
+// CI/CD ID tokens.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/ci/secrets/id_token_authentication.html
+type JSONWebKey struct {
+	KeyID     string `json:"kid"`
+	KeyType   string `json:"kty"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+// PublicKey decodes the RSA public key held by the JSON web key.
+func (k *JSONWebKey) PublicKey() (*rsa.PublicKey, error) {
+	if k.KeyType != "RSA" {
+		return nil, fmt.Errorf("jwk %s: unsupported key type %q", k.KeyID, k.KeyType)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("jwk %s: %w", k.KeyID, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("jwk %s: %w", k.KeyID, err)
+	}
+	exp := new(big.Int).SetBytes(e)
+	if !exp.IsInt64() || exp.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("jwk %s: invalid exponent", k.KeyID)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exp.Int64()),
+	}, nil
+}
+
+// JSONWebKeySet represents the key set published by the GitLab instance.
+type JSONWebKeySet struct {
+	Keys []*JSONWebKey `json:"keys"`
+}
+
+// Key returns the key with the given key id, or nil.
+func (s *JSONWebKeySet) Key(kid string) *JSONWebKey {
+	if s == nil {
+		return nil
+	}
+	for _, k := range s.Keys {
+		if k.KeyID == kid {
+			return k
+		}
+	}
+	return nil
+}
+
+// ListSigningKeys gets the public keys the instance uses to sign ID tokens.
+// The endpoint does not require authentication.
+//
+// GitLab docs: https://docs.gitlab.com/ee/integration/openid_connect_provider.html
+func (oa *OAuthService) ListSigningKeys(ctx context.Context) (*JSONWebKeySet, error) {
+	var v JSONWebKeySet
+	if _, err := oa.client.Invoke(ctx, http.MethodGet, "/oauth/discovery/keys", nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SigningKeySet resolves the public key that signed an ID token.
+type SigningKeySet interface {
+	PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error)
+}
+
+// StaticSigningKeySet is a SigningKeySet backed by a fixed JSONWebKeySet,
+// useful for tests and offline verification.
+type StaticSigningKeySet JSONWebKeySet
+
+func (s *StaticSigningKeySet) PublicKey(_ context.Context, kid string) (*rsa.PublicKey, error) {
+	k := (*JSONWebKeySet)(s).Key(kid)
+	if k == nil {
+		return nil, ErrIDTokenKeyID
+	}
+	return k.PublicKey()
+}
+
+const (
+	defaultKeySetTTL         = time.Hour
+	defaultKeySetMinInterval = time.Minute
+)
+
+// RemoteSigningKeySet is a SigningKeySet that fetches and caches the
+// instance key set. Unknown key ids trigger a refresh, rate limited by
+// MinRefreshInterval, so key rotation is picked up without a restart.
+type RemoteSigningKeySet struct {
+	client *Client
+
+	// TTL is how long a fetched key set is trusted. Zero means the
+	// default of 1h.
+	TTL time.Duration
+	// MinRefreshInterval bounds refreshes caused by unknown key ids. Zero
+	// means the default of 1m.
+	MinRefreshInterval time.Duration
+
+	mu        sync.Mutex
+	keys      *JSONWebKeySet
+	fetchedAt time.Time
+}
+
+func NewRemoteSigningKeySet(client *Client) *RemoteSigningKeySet {
+	return &RemoteSigningKeySet{
+		client:             client,
+		TTL:                defaultKeySetTTL,
+		MinRefreshInterval: defaultKeySetMinInterval,
+	}
+}
+
+func (r *RemoteSigningKeySet) PublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ttl := r.TTL
+	if ttl <= 0 {
+		ttl = defaultKeySetTTL
+	}
+	minInterval := r.MinRefreshInterval
+	if minInterval <= 0 {
+		minInterval = defaultKeySetMinInterval
+	}
+
+	age := time.Since(r.fetchedAt)
+	if r.keys == nil || age > ttl {
+		if err := r.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	k := r.keys.Key(kid)
+	if k == nil && time.Since(r.fetchedAt) > minInterval {
+		if err := r.refresh(ctx); err != nil {
+			return nil, err
+		}
+		k = r.keys.Key(kid)
+	}
+	if k == nil {
+		return nil, ErrIDTokenKeyID
+	}
+	return k.PublicKey()
+}
+
+func (r *RemoteSigningKeySet) refresh(ctx context.Context) error {
+	keys, err := r.client.OAuth.ListSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+	r.keys = keys
+	r.fetchedAt = time.Now()
+	return nil
+}
+
+// IDTokenClaims represents the claims of a GitLab CI/CD ID token
+// (id_tokens, or the deprecated CI_JOB_JWT).
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/secrets/id_token_authentication.html#token-payload
+type IDTokenClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  []string `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	IssuedAt  int64    `json:"iat"`
+	JWTID     string   `json:"jti"`
+
+	NamespaceID          int    `json:"namespace_id"`
+	NamespacePath        string `json:"namespace_path"`
+	ProjectID            int    `json:"project_id"`
+	ProjectPath          string `json:"project_path"`
+	ProjectVisibility    string `json:"project_visibility"`
+	UserID               int    `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserEmail            string `json:"user_email"`
+	UserAccessLevel      string `json:"user_access_level"`
+	PipelineID           int    `json:"pipeline_id"`
+	PipelineSource       string `json:"pipeline_source"`
+	JobID                int    `json:"job_id"`
+	Ref                  string `json:"ref"`
+	RefType              string `json:"ref_type"`
+	RefPath              string `json:"ref_path"`
+	RefProtected         bool   `json:"ref_protected"`
+	Environment          string `json:"environment"`
+	EnvironmentProtected bool   `json:"environment_protected"`
+	EnvironmentAction    string `json:"environment_action"`
+	DeploymentTier       string `json:"deployment_tier"`
+	RunnerID             int    `json:"runner_id"`
+	RunnerEnvironment    string `json:"runner_environment"`
+	SHA                  string `json:"sha"`
+	CIConfigRefURI       string `json:"ci_config_ref_uri"`
+	CIConfigSHA          string `json:"ci_config_sha"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// GitLab encodes ids and booleans as strings, and aud as a string or array.
+func (c *IDTokenClaims) UnmarshalJSON(data []byte) error {
+	type alias IDTokenClaims
+	var raw struct {
+		alias
+		Audience             json.RawMessage `json:"aud"`
+		NamespaceID          flexString      `json:"namespace_id"`
+		ProjectID            flexString      `json:"project_id"`
+		UserID               flexString      `json:"user_id"`
+		PipelineID           flexString      `json:"pipeline_id"`
+		JobID                flexString      `json:"job_id"`
+		RunnerID             flexString      `json:"runner_id"`
+		RefProtected         flexString      `json:"ref_protected"`
+		EnvironmentProtected flexString      `json:"environment_protected"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*c = IDTokenClaims(raw.alias)
+
+	if len(raw.Audience) > 0 && raw.Audience[0] == '[' {
+		if err := json.Unmarshal(raw.Audience, &c.Audience); err != nil {
+			return err
+		}
+	} else if len(raw.Audience) > 0 {
+		var aud string
+		if err := json.Unmarshal(raw.Audience, &aud); err != nil {
+			return err
+		}
+		c.Audience = []string{aud}
+	}
+
+	c.NamespaceID, _ = strconv.Atoi(string(raw.NamespaceID))
+	c.ProjectID, _ = strconv.Atoi(string(raw.ProjectID))
+	c.UserID, _ = strconv.Atoi(string(raw.UserID))
+	c.PipelineID, _ = strconv.Atoi(string(raw.PipelineID))
+	c.JobID, _ = strconv.Atoi(string(raw.JobID))
+	c.RunnerID, _ = strconv.Atoi(string(raw.RunnerID))
+	c.RefProtected = raw.RefProtected == "true"
+	c.EnvironmentProtected = raw.EnvironmentProtected == "true"
+	return nil
+}
+
+// flexString accepts a JSON string, number or boolean.
+type flexString string
+
+func (f *flexString) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*f = flexString(s)
+		return nil
+	}
+	*f = flexString(data)
+	return nil
+}
+
+// IDTokenVerifier validates GitLab CI/CD ID tokens.
+type IDTokenVerifier struct {
+	// Keys resolves signing keys, usually a RemoteSigningKeySet.
+	Keys SigningKeySet
+	// Issuer is the expected iss claim, the instance URL.
+	Issuer string
+	// Audience is the expected aud claim, as configured in id_tokens.
+	// Empty skips the audience check.
+	Audience string
+	// Leeway tolerates clock skew when checking exp, nbf and iat.
+	Leeway time.Duration
+
+	now func() time.Time
+}
+
+// NewIDTokenVerifier returns a verifier for ID tokens issued by the instance
+// the client points at, fetching and caching its key set.
+func NewIDTokenVerifier(client *Client, audience string) *IDTokenVerifier {
+	issuer := CloudEndpoint
+	if client.OAuth.credential != nil && client.OAuth.credential.GetEndpoint() != "" {
+		issuer = client.OAuth.credential.GetEndpoint()
+	}
+	return &IDTokenVerifier{
+		Keys:     NewRemoteSigningKeySet(client),
+		Issuer:   strings.TrimRight(issuer, "/"),
+		Audience: audience,
+	}
+}
+
+var idTokenHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+}
+
+// Verify checks the signature, issuer, audience and validity window of the
+// raw token and returns its claims.
+func (v *IDTokenVerifier) Verify(ctx context.Context, rawToken string) (*IDTokenClaims, error) {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrIDTokenMalformed
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	hash, ok := idTokenHashes[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrIDTokenMalformed, header.Alg)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrIDTokenMalformed
+	}
+	key, err := v.Keys.PublicKey(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	h := hash.New()
+	h.Write([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(key, hash, h.Sum(nil), sig); err != nil {
+		return nil, ErrIDTokenSignature
+	}
+
+	var claims IDTokenClaims
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	if err = v.validate(&claims); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+func (v *IDTokenVerifier) validate(c *IDTokenClaims) error {
+	now := time.Now()
+	if v.now != nil {
+		now = v.now()
+	}
+
+	if v.Issuer != "" && strings.TrimRight(c.Issuer, "/") != strings.TrimRight(v.Issuer, "/") {
+		return ErrIDTokenIssuer
+	}
+	if v.Audience != "" {
+		found := false
+		for _, aud := range c.Audience {
+			if aud == v.Audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ErrIDTokenAudience
+		}
+	}
+	if c.ExpiresAt == 0 || now.After(time.Unix(c.ExpiresAt, 0).Add(v.Leeway)) {
+		return ErrIDTokenExpired
+	}
+	if c.NotBefore != 0 && now.Add(v.Leeway).Before(time.Unix(c.NotBefore, 0)) {
+		return ErrIDTokenNotYet
+	}
+	if c.IssuedAt != 0 && now.Add(v.Leeway).Before(time.Unix(c.IssuedAt, 0)) {
+		return ErrIDTokenNotYet
+	}
+	return nil
+}
+
+func decodeSegment(seg string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return ErrIDTokenMalformed
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrIDTokenMalformed, err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package gitlab
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func signTestIDToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signing := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	sum := sha256.Sum256([]byte(signing))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestIDTokenVerifier_Verify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySet := &StaticSigningKeySet{Keys: []*JSONWebKey{{
+		KeyID:   "kid-1",
+		KeyType: "RSA",
+		N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+
+	now := time.Unix(1700000000, 0)
+	claims := map[string]any{
+		"iss":             "https://gitlab.example.com",
+		"aud":             "https://deploy.example.com",
+		"exp":             now.Add(time.Minute).Unix(),
+		"nbf":             now.Add(-time.Minute).Unix(),
+		"iat":             now.Add(-time.Minute).Unix(),
+		"project_id":      "22",
+		"project_path":    "mygroup/myproject",
+		"ref":             "main",
+		"ref_protected":   "true",
+		"pipeline_id":     "1212",
+		"pipeline_source": "push",
+		"environment":     "production",
+		"runner_id":       1,
+	}
+
+	v := &IDTokenVerifier{
+		Keys:     keySet,
+		Issuer:   "https://gitlab.example.com/",
+		Audience: "https://deploy.example.com",
+		now:      func() time.Time { return now },
+	}
+
+	got, err := v.Verify(context.Background(), signTestIDToken(t, key, "kid-1", claims))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ProjectID != 22 || got.ProjectPath != "mygroup/myproject" || !got.RefProtected ||
+		got.PipelineID != 1212 || got.RunnerID != 1 || got.Environment != "production" {
+		t.Errorf("unexpected claims: %+v", got)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(map[string]any) (kid string)
+		want   error
+	}{
+		{"expired", func(c map[string]any) string { c["exp"] = now.Add(-time.Second).Unix(); return "kid-1" }, ErrIDTokenExpired},
+		{"audience", func(c map[string]any) string { c["aud"] = []string{"other"}; return "kid-1" }, ErrIDTokenAudience},
+		{"issuer", func(c map[string]any) string { c["iss"] = "https://evil.example.com"; return "kid-1" }, ErrIDTokenIssuer},
+		{"kid", func(c map[string]any) string { return "kid-2" }, ErrIDTokenKeyID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := make(map[string]any, len(claims))
+			for k, val := range claims {
+				c[k] = val
+			}
+			kid := tt.mutate(c)
+			if _, err := v.Verify(context.Background(), signTestIDToken(t, key, kid, c)); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	if _, err = v.Verify(context.Background(), signTestIDToken(t, other, "kid-1", claims)); !errors.Is(err, ErrIDTokenSignature) {
+		t.Errorf("got %v, want %v", err, ErrIDTokenSignature)
+	}
+}
+
+func TestRemoteSigningKeySet_ZeroValueDefaults(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kid": "kid-1",
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	defer srv.Close()
+
+	// A struct literal leaves TTL and MinRefreshInterval zero.
+	keySet := &RemoteSigningKeySet{client: NewClient(&TokenCredential{Endpoint: srv.URL})}
+	for i := 0; i < 3; i++ {
+		if _, err = keySet.PublicKey(context.Background(), "kid-1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = keySet.PublicKey(context.Background(), "kid-2"); !errors.Is(err, ErrIDTokenKeyID) {
+		t.Errorf("got %v, want %v", err, ErrIDTokenKeyID)
+	}
+	if fetches != 1 {
+		t.Errorf("key set fetched %d times, want 1", fetches)
+	}
+}
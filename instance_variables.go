@@ -0,0 +1,129 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// InstanceVariablesService handles communication with the instance-level
+// CI/CD variables related methods of the GitLab API. Only available to
+// admins.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/instance_level_ci_variables.html
+type InstanceVariablesService service
+
+// InstanceVariable represents an instance-level CI/CD variable. Instance
+// variables have no environment scope.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/instance_level_ci_variables.html
+type InstanceVariable struct {
+	Key          string            `json:"key"`
+	Value        string            `json:"value"`
+	VariableType VariableTypeValue `json:"variable_type"`
+	Protected    bool              `json:"protected"`
+	Masked       bool              `json:"masked"`
+	Raw          bool              `json:"raw"`
+	Description  string            `json:"description"`
+}
+
+// ListInstanceVariablesOptions represents the available
+// ListInstanceVariables() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#list-all-instance-variables
+type ListInstanceVariablesOptions struct {
+	ListOptions `query:",inline"`
+}
+
+// ListInstanceVariables gets a list of the instance-level CI/CD variables.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#list-all-instance-variables
+func (s *InstanceVariablesService) ListInstanceVariables(ctx context.Context, opts *ListInstanceVariablesOptions) (*Records[InstanceVariable], error) {
+	var v []*InstanceVariable
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, "admin/ci/variables", opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetInstanceVariable gets a single instance-level CI/CD variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#show-instance-variable-details
+func (s *InstanceVariablesService) GetInstanceVariable(ctx context.Context, key string) (*InstanceVariable, error) {
+	apiEndpoint := fmt.Sprintf("admin/ci/variables/%s", key)
+	var v InstanceVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateInstanceVariableOptions represents the available
+// CreateInstanceVariable() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#create-instance-variable
+type CreateInstanceVariableOptions struct {
+	Key          *string            `json:"key,omitempty"`
+	Value        *string            `json:"value,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+	Masked       *bool              `json:"masked,omitempty"`
+	Protected    *bool              `json:"protected,omitempty"`
+	Raw          *bool              `json:"raw,omitempty"`
+	VariableType *VariableTypeValue `json:"variable_type,omitempty"`
+}
+
+// CreateInstanceVariable creates an instance-level CI/CD variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#create-instance-variable
+func (s *InstanceVariablesService) CreateInstanceVariable(ctx context.Context, opts *CreateInstanceVariableOptions) (*InstanceVariable, error) {
+	var v InstanceVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, "admin/ci/variables", opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateInstanceVariableOptions represents the available
+// UpdateInstanceVariable() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#update-instance-variable
+type UpdateInstanceVariableOptions struct {
+	Value        *string            `json:"value,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+	Masked       *bool              `json:"masked,omitempty"`
+	Protected    *bool              `json:"protected,omitempty"`
+	Raw          *bool              `json:"raw,omitempty"`
+	VariableType *VariableTypeValue `json:"variable_type,omitempty"`
+}
+
+// UpdateInstanceVariable updates an instance-level CI/CD variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#update-instance-variable
+func (s *InstanceVariablesService) UpdateInstanceVariable(ctx context.Context, key string, opts *UpdateInstanceVariableOptions) (*InstanceVariable, error) {
+	apiEndpoint := fmt.Sprintf("admin/ci/variables/%s", key)
+	var v InstanceVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteInstanceVariable deletes an instance-level CI/CD variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#remove-instance-variable
+func (s *InstanceVariablesService) DeleteInstanceVariable(ctx context.Context, key string) error {
+	apiEndpoint := fmt.Sprintf("admin/ci/variables/%s", key)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
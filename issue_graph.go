@@ -0,0 +1,282 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const defaultMaxGraphIssues = 1000
+
+var (
+	// ErrIssueCycle is returned by IssueGraph.TopologicalOrder when blocking
+	// links form a cycle.
+	ErrIssueCycle = errors.New("gitlab: cycle in blocking issue links")
+	// ErrTooManyIssues is returned by BuildIssueGraph when the linked issues
+	// exceed BuildIssueGraphOptions.MaxIssues.
+	ErrTooManyIssues = errors.New("gitlab: too many linked issues")
+)
+
+// IssueKey identifies an issue across projects.
+type IssueKey struct {
+	ProjectID int
+	IID       int
+}
+
+func (k IssueKey) String() string {
+	return strconv.Itoa(k.ProjectID) + "#" + strconv.Itoa(k.IID)
+}
+
+func (k IssueKey) less(o IssueKey) bool {
+	if k.ProjectID != o.ProjectID {
+		return k.ProjectID < o.ProjectID
+	}
+	return k.IID < o.IID
+}
+
+// IssueGraph is a dependency graph of issues, possibly from several
+// projects, built from their blocking links. An edge goes from a blocking
+// issue to the issue it blocks; relates_to links are ignored.
+//
+// The zero value is not usable; create graphs with NewIssueGraph or
+// IssueLinksService.BuildIssueGraph.
+type IssueGraph struct {
+	issues map[IssueKey]*Issue
+	blocks map[IssueKey]map[IssueKey]bool
+}
+
+// NewIssueGraph returns an empty issue graph.
+func NewIssueGraph() *IssueGraph {
+	return &IssueGraph{
+		issues: make(map[IssueKey]*Issue),
+		blocks: make(map[IssueKey]map[IssueKey]bool),
+	}
+}
+
+// AddIssue adds an issue to the graph and returns its key.
+func (g *IssueGraph) AddIssue(issue *Issue) IssueKey {
+	k := IssueKey{ProjectID: issue.ProjectID, IID: issue.IID}
+	g.issues[k] = issue
+	if g.blocks[k] == nil {
+		g.blocks[k] = make(map[IssueKey]bool)
+	}
+	return k
+}
+
+// AddBlock records that blocker blocks blocked, adding both issues to the
+// graph if needed.
+func (g *IssueGraph) AddBlock(blocker, blocked IssueKey) {
+	for _, k := range []IssueKey{blocker, blocked} {
+		if g.blocks[k] == nil {
+			g.blocks[k] = make(map[IssueKey]bool)
+		}
+	}
+	g.blocks[blocker][blocked] = true
+}
+
+// AddRelations adds an issue and its blocking links, as returned by
+// IssueLinksService.ListIssueRelations.
+func (g *IssueGraph) AddRelations(issue *Issue, relations []*IssueRelation) {
+	k := g.AddIssue(issue)
+	for _, rel := range relations {
+		switch rel.LinkType {
+		case BlocksIssueLink:
+			g.AddBlock(k, g.addLinked(&rel.Issue))
+		case IsBlockedByIssueLink:
+			g.AddBlock(g.addLinked(&rel.Issue), k)
+		}
+	}
+}
+
+// addLinked adds an issue seen through a link, without replacing the issue
+// if it was added on its own.
+func (g *IssueGraph) addLinked(issue *Issue) IssueKey {
+	k := IssueKey{ProjectID: issue.ProjectID, IID: issue.IID}
+	if _, ok := g.issues[k]; !ok {
+		g.AddIssue(issue)
+	}
+	return k
+}
+
+// Issue returns the issue of a key, or nil if only its links are known.
+func (g *IssueGraph) Issue(k IssueKey) *Issue {
+	return g.issues[k]
+}
+
+// Keys returns the keys of every issue of the graph, sorted.
+func (g *IssueGraph) Keys() []IssueKey {
+	keys := make([]IssueKey, 0, len(g.blocks))
+	for k := range g.blocks {
+		keys = append(keys, k)
+	}
+	sortIssueKeys(keys)
+	return keys
+}
+
+// Blocks returns the issues blocked by k, sorted.
+func (g *IssueGraph) Blocks(k IssueKey) []IssueKey {
+	keys := make([]IssueKey, 0, len(g.blocks[k]))
+	for b := range g.blocks[k] {
+		keys = append(keys, b)
+	}
+	sortIssueKeys(keys)
+	return keys
+}
+
+// BlockedBy returns the issues blocking k, sorted.
+func (g *IssueGraph) BlockedBy(k IssueKey) []IssueKey {
+	var keys []IssueKey
+	for blocker, blocked := range g.blocks {
+		if blocked[k] {
+			keys = append(keys, blocker)
+		}
+	}
+	sortIssueKeys(keys)
+	return keys
+}
+
+// Cycles returns cycles of blocking links, each as the issues along it,
+// starting from its smallest key. Every issue that is part of a cycle
+// appears in at least one of them, but not every elementary cycle is
+// listed. It returns nil when the graph is a DAG.
+func (g *IssueGraph) Cycles() [][]IssueKey {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[IssueKey]int, len(g.blocks))
+	var (
+		stack  []IssueKey
+		cycles [][]IssueKey
+		visit  func(k IssueKey)
+	)
+	visit = func(k IssueKey) {
+		state[k] = visiting
+		stack = append(stack, k)
+		for _, next := range g.Blocks(k) {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				i := len(stack) - 1
+				for stack[i] != next {
+					i--
+				}
+				cycles = append(cycles, rotateIssueCycle(stack[i:]))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[k] = done
+	}
+	for _, k := range g.Keys() {
+		if state[k] == unvisited {
+			visit(k)
+		}
+	}
+	return cycles
+}
+
+// TopologicalOrder returns the issues so that every issue comes after the
+// issues blocking it, or an error wrapping ErrIssueCycle.
+func (g *IssueGraph) TopologicalOrder() ([]IssueKey, error) {
+	indegree := make(map[IssueKey]int, len(g.blocks))
+	for _, blocked := range g.blocks {
+		for k := range blocked {
+			indegree[k]++
+		}
+	}
+	var ready, order []IssueKey
+	for _, k := range g.Keys() {
+		if indegree[k] == 0 {
+			ready = append(ready, k)
+		}
+	}
+	for len(ready) > 0 {
+		k := ready[0]
+		ready = ready[1:]
+		order = append(order, k)
+		for _, next := range g.Blocks(k) {
+			if indegree[next]--; indegree[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+	if len(order) != len(g.blocks) {
+		return nil, fmt.Errorf("%w: %v", ErrIssueCycle, g.Cycles()[0])
+	}
+	return order, nil
+}
+
+func rotateIssueCycle(path []IssueKey) []IssueKey {
+	start := 0
+	for i, k := range path {
+		if k.less(path[start]) {
+			start = i
+		}
+	}
+	cycle := make([]IssueKey, 0, len(path))
+	cycle = append(cycle, path[start:]...)
+	return append(cycle, path[:start]...)
+}
+
+func sortIssueKeys(keys []IssueKey) {
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+}
+
+// BuildIssueGraphOptions represents the available BuildIssueGraph() options.
+type BuildIssueGraphOptions struct {
+	// MaxIssues bounds the number of issues fetched. Default: 1000.
+	MaxIssues int
+}
+
+// BuildIssueGraph builds the dependency graph of issues reachable from the
+// given issues of a project through blocking links, following them across
+// projects. Use IssueGraph.Cycles to detect circular dependencies.
+func (s *IssueLinksService) BuildIssueGraph(ctx context.Context, pid string, iids []int, opts *BuildIssueGraphOptions) (*IssueGraph, error) {
+	maxIssues := defaultMaxGraphIssues
+	if opts != nil && opts.MaxIssues > 0 {
+		maxIssues = opts.MaxIssues
+	}
+
+	g := NewIssueGraph()
+	var queue []*Issue
+	seen := make(map[IssueKey]bool)
+	for _, iid := range iids {
+		issue, err := s.client.Issues.GetIssue(ctx, pid, iid)
+		if err != nil {
+			return nil, err
+		}
+		if k := g.AddIssue(issue); !seen[k] {
+			seen[k] = true
+			queue = append(queue, issue)
+		}
+	}
+
+	for len(queue) > 0 {
+		issue := queue[0]
+		queue = queue[1:]
+		relations, err := s.ListIssueRelations(ctx, strconv.Itoa(issue.ProjectID), issue.IID)
+		if err != nil {
+			return nil, err
+		}
+		g.AddRelations(issue, relations)
+		for _, rel := range relations {
+			if rel.LinkType != BlocksIssueLink && rel.LinkType != IsBlockedByIssueLink {
+				continue
+			}
+			k := IssueKey{ProjectID: rel.ProjectID, IID: rel.IID}
+			if seen[k] {
+				continue
+			}
+			if len(seen) >= maxIssues {
+				return g, fmt.Errorf("%w: more than %d", ErrTooManyIssues, maxIssues)
+			}
+			seen[k] = true
+			queue = append(queue, &rel.Issue)
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,79 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestIssueGraph_TopologicalOrder(t *testing.T) {
+	a, b, c, d := IssueKey{1, 1}, IssueKey{1, 2}, IssueKey{2, 1}, IssueKey{2, 2}
+
+	g := NewIssueGraph()
+	g.AddBlock(a, b)
+	g.AddBlock(b, c)
+	g.AddBlock(a, d)
+
+	order, err := g.TopologicalOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []IssueKey{a, b, d, c}; !reflect.DeepEqual(order, want) {
+		t.Errorf("got order %v, want %v", order, want)
+	}
+	if cycles := g.Cycles(); cycles != nil {
+		t.Errorf("got cycles %v in a DAG", cycles)
+	}
+	if got, want := g.BlockedBy(c), []IssueKey{b}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got blockers %v, want %v", got, want)
+	}
+
+	g.AddBlock(c, a)
+	if _, err := g.TopologicalOrder(); !errors.Is(err, ErrIssueCycle) {
+		t.Errorf("got error %v, want ErrIssueCycle", err)
+	}
+	if got, want := g.Cycles(), [][]IssueKey{{a, b, c}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got cycles %v, want %v", got, want)
+	}
+}
+
+func TestIssueLinksService_BuildIssueGraph(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/group%2Fapp/issues/1":
+			_, _ = w.Write([]byte(`{"project_id":10,"iid":1}`))
+		case "/api/v4/projects/10/issues/1/links":
+			_, _ = w.Write([]byte(`[
+				{"project_id":20,"iid":5,"link_type":"is_blocked_by"},
+				{"project_id":10,"iid":9,"link_type":"relates_to"}]`))
+		case "/api/v4/projects/20/issues/5/links":
+			_, _ = w.Write([]byte(`[
+				{"project_id":10,"iid":1,"link_type":"blocks"},
+				{"project_id":20,"iid":6,"link_type":"is_blocked_by"}]`))
+		case "/api/v4/projects/20/issues/6/links":
+			_, _ = w.Write([]byte(`[{"project_id":20,"iid":5,"link_type":"blocks"}]`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(&TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	g, err := client.IssueLinks.BuildIssueGraph(context.Background(), "group%2Fapp", []int{1}, nil)
+	if err != nil {
+		t.Fatalf("IssueLinks.BuildIssueGraph returned error: %v", err)
+	}
+	order, err := g.TopologicalOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []IssueKey{{20, 6}, {20, 5}, {10, 1}}; !reflect.DeepEqual(order, want) {
+		t.Errorf("got order %v, want %v", order, want)
+	}
+}
@@ -0,0 +1,114 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// IssueLinksService handles communication with the issue links related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issue_links.html
+type IssueLinksService service
+
+// IssueLinkTypeValue represents the type of a link between two issues.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issue_links.html
+type IssueLinkTypeValue string
+
+// List of available issue link types.
+const (
+	RelatesToIssueLink   IssueLinkTypeValue = "relates_to"
+	BlocksIssueLink      IssueLinkTypeValue = "blocks"
+	IsBlockedByIssueLink IssueLinkTypeValue = "is_blocked_by"
+)
+
+// IssueLink represents a link between two issues.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issue_links.html
+type IssueLink struct {
+	SourceIssue *Issue             `json:"source_issue"`
+	TargetIssue *Issue             `json:"target_issue"`
+	LinkType    IssueLinkTypeValue `json:"link_type"`
+}
+
+// IssueRelation represents an issue linked to another one. LinkType is seen
+// from the issue the relations were listed for: blocks means that issue
+// blocks this one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issue_links.html#list-issue-relations
+type IssueRelation struct {
+	Issue
+
+	IssueLinkID   int                `json:"issue_link_id"`
+	LinkType      IssueLinkTypeValue `json:"link_type"`
+	LinkCreatedAt *time.Time         `json:"link_created_at"`
+	LinkUpdatedAt *time.Time         `json:"link_updated_at"`
+}
+
+// ListIssueRelations gets the issues linked to an issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issue_links.html#list-issue-relations
+func (s *IssueLinksService) ListIssueRelations(ctx context.Context, pid string, iid int) ([]*IssueRelation, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/links", pid, iid)
+	var v []*IssueRelation
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetIssueLink gets a single link of an issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issue_links.html#get-an-issue-link
+func (s *IssueLinksService) GetIssueLink(ctx context.Context, pid string, iid, link int) (*IssueLink, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/links/%d", pid, iid, link)
+	var v IssueLink
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateIssueLinkOptions represents the available CreateIssueLink() options.
+// TargetProjectID is the ID or URL-encoded path of the target project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issue_links.html#create-an-issue-link
+type CreateIssueLinkOptions struct {
+	TargetProjectID *string             `json:"target_project_id,omitempty"`
+	TargetIssueIID  *string             `json:"target_issue_iid,omitempty"`
+	LinkType        *IssueLinkTypeValue `json:"link_type,omitempty"`
+}
+
+// CreateIssueLink links an issue to another one, possibly in another
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issue_links.html#create-an-issue-link
+func (s *IssueLinksService) CreateIssueLink(ctx context.Context, pid string, iid int, opts *CreateIssueLinkOptions) (*IssueLink, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/links", pid, iid)
+	var v IssueLink
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteIssueLink deletes a link of an issue and returns it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issue_links.html#delete-an-issue-link
+func (s *IssueLinksService) DeleteIssueLink(ctx context.Context, pid string, iid, link int) (*IssueLink, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/links/%d", pid, iid, link)
+	var v IssueLink
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
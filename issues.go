@@ -1,5 +1,71 @@
 package gitlab
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// IssuesService handles communication with the issue related methods of
+// the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html
+type IssuesService service
+
+// Issue represents a GitLab issue.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html
+type Issue struct {
+	ID                   int                    `json:"id"`
+	IID                  int                    `json:"iid"`
+	ExternalID           string                 `json:"external_id"`
+	ProjectID            int                    `json:"project_id"`
+	Title                string                 `json:"title"`
+	Description          string                 `json:"description"`
+	State                string                 `json:"state"`
+	CreatedAt            *time.Time             `json:"created_at"`
+	UpdatedAt            *time.Time             `json:"updated_at"`
+	ClosedAt             *time.Time             `json:"closed_at"`
+	ClosedBy             *BasicUser             `json:"closed_by"`
+	Labels               Labels                 `json:"labels"`
+	LabelDetails         []*LabelDetails        `json:"label_details"`
+	Milestone            *Milestone             `json:"milestone"`
+	Author               *BasicUser             `json:"author"`
+	Assignee             *BasicUser             `json:"assignee"`
+	Assignees            []*BasicUser           `json:"assignees"`
+	Type                 string                 `json:"type"`
+	IssueType            string                 `json:"issue_type"`
+	UserNotesCount       int                    `json:"user_notes_count"`
+	MergeRequestsCount   int                    `json:"merge_requests_count"`
+	Upvotes              int                    `json:"upvotes"`
+	Downvotes            int                    `json:"downvotes"`
+	DueDate              *Date                  `json:"due_date"`
+	Confidential         bool                   `json:"confidential"`
+	DiscussionLocked     bool                   `json:"discussion_locked"`
+	WebURL               string                 `json:"web_url"`
+	TimeStats            *TimeStats             `json:"time_stats"`
+	TaskCompletionStatus *TasksCompletionStatus `json:"task_completion_status"`
+	Weight               int                    `json:"weight"`
+	HasTasks             bool                   `json:"has_tasks"`
+	TaskStatus           string                 `json:"task_status"`
+	References           *IssueReferences       `json:"references"`
+	Severity             string                 `json:"severity"`
+	Subscribed           bool                   `json:"subscribed"`
+	MovedToID            int                    `json:"moved_to_id"`
+	EpicIID              int                    `json:"epic_iid"`
+	HealthStatus         string                 `json:"health_status"`
+	Links                *IssueLinks            `json:"_links"`
+}
+
+// IssueLinks represents links of the issue.
+type IssueLinks struct {
+	Self       string `json:"self"`
+	Notes      string `json:"notes"`
+	AwardEmoji string `json:"award_emoji"`
+	Project    string `json:"project"`
+}
+
 // LabelDetails represents detailed label information.
 type LabelDetails struct {
 	ID              int    `json:"id"`
@@ -16,3 +82,311 @@ type IssueReferences struct {
 	Relative string `json:"relative"`
 	Full     string `json:"full"`
 }
+
+// ListIssuesOptions represents the available ListIssues(),
+// ListGroupIssues() and ListProjectIssues() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-issues
+type ListIssuesOptions struct {
+	ListOptions `query:",inline"`
+
+	// State is one of opened, closed or all.
+	State *string `query:"state,omitempty"`
+	// Scope is one of created_by_me, assigned_to_me or all.
+	Scope             *string    `query:"scope,omitempty"`
+	Labels            *Labels    `query:"labels,comma,omitempty"`
+	NotLabels         *Labels    `query:"not[labels],comma,omitempty"`
+	WithLabelsDetails *bool      `query:"with_labels_details,omitempty"`
+	Milestone         *string    `query:"milestone,omitempty"`
+	NotMilestone      *string    `query:"not[milestone],omitempty"`
+	AuthorID          *int       `query:"author_id,omitempty"`
+	AuthorUsername    *string    `query:"author_username,omitempty"`
+	AssigneeID        *int       `query:"assignee_id,omitempty"`
+	AssigneeUsername  *[]string  `query:"assignee_username[],omitempty"`
+	MyReactionEmoji   *string    `query:"my_reaction_emoji,omitempty"`
+	IIDs              *[]int     `query:"iids[],omitempty"`
+	Search            *string    `query:"search,omitempty"`
+	In                *string    `query:"in,omitempty"`
+	Confidential      *bool      `query:"confidential,omitempty"`
+	IssueType         *string    `query:"issue_type,omitempty"`
+	Weight            *int       `query:"weight,omitempty"`
+	CreatedAfter      *time.Time `query:"created_after,omitempty"`
+	CreatedBefore     *time.Time `query:"created_before,omitempty"`
+	UpdatedAfter      *time.Time `query:"updated_after,omitempty"`
+	UpdatedBefore     *time.Time `query:"updated_before,omitempty"`
+	// DueDate is one of 0 (no due date), any, today, tomorrow, overdue,
+	// week, month or next_month_and_previous_two_weeks.
+	DueDate     *string `query:"due_date,omitempty"`
+	NonArchived *bool   `query:"non_archived,omitempty"`
+}
+
+// ListIssues gets a list of the issues the user has access to. By default
+// only those created by the user are returned; set Scope to all for every
+// issue.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-issues
+func (s *IssuesService) ListIssues(ctx context.Context, opts *ListIssuesOptions) (*Records[Issue], error) {
+	var v []*Issue
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, "issues", opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListGroupIssues gets a list of the issues of a group and its subgroups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#list-group-issues
+func (s *IssuesService) ListGroupIssues(ctx context.Context, gid string, opts *ListIssuesOptions) (*Records[Issue], error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/issues", gid)
+	var v []*Issue
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListProjectIssues gets a list of the issues of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#list-project-issues
+func (s *IssuesService) ListProjectIssues(ctx context.Context, pid string, opts *ListIssuesOptions) (*Records[Issue], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues", pid)
+	var v []*Issue
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetIssue gets a single issue of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#single-project-issue
+func (s *IssuesService) GetIssue(ctx context.Context, pid string, iid int) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d", pid, iid)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetIssueByID gets a single issue by its global ID. Only administrators
+// can use it.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#single-issue
+func (s *IssuesService) GetIssueByID(ctx context.Context, id int) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("issues/%d", id)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateIssueOptions represents the available CreateIssue() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#new-issue
+type CreateIssueOptions struct {
+	IID                                *int       `json:"iid,omitempty"`
+	Title                              *string    `json:"title,omitempty"`
+	Description                        *string    `json:"description,omitempty"`
+	Confidential                       *bool      `json:"confidential,omitempty"`
+	AssigneeIDs                        *[]int     `json:"assignee_ids,omitempty"`
+	MilestoneID                        *int       `json:"milestone_id,omitempty"`
+	Labels                             *Labels    `json:"labels,omitempty"`
+	CreatedAt                          *time.Time `json:"created_at,omitempty"`
+	DueDate                            *Date      `json:"due_date,omitempty"`
+	MergeRequestToResolveDiscussionsOf *int       `json:"merge_request_to_resolve_discussions_of,omitempty"`
+	DiscussionToResolve                *string    `json:"discussion_to_resolve,omitempty"`
+	Weight                             *int       `json:"weight,omitempty"`
+	EpicID                             *int       `json:"epic_id,omitempty"`
+	IssueType                          *string    `json:"issue_type,omitempty"`
+}
+
+// CreateIssue creates a new issue in a project.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#new-issue
+func (s *IssuesService) CreateIssue(ctx context.Context, pid string, opts *CreateIssueOptions) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues", pid)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateIssueOptions represents the available UpdateIssue() options.
+// Labels replaces every label; AddLabels and RemoveLabels change only the
+// given ones. An empty AssigneeIDs list unassigns everyone.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-an-issue
+type UpdateIssueOptions struct {
+	Title            *string          `json:"title,omitempty"`
+	Description      *string          `json:"description,omitempty"`
+	Confidential     *bool            `json:"confidential,omitempty"`
+	AssigneeIDs      *[]int           `json:"assignee_ids,omitempty"`
+	MilestoneID      *int             `json:"milestone_id,omitempty"`
+	Labels           *Labels          `json:"labels,omitempty"`
+	AddLabels        *Labels          `json:"add_labels,omitempty"`
+	RemoveLabels     *Labels          `json:"remove_labels,omitempty"`
+	StateEvent       *StateEventValue `json:"state_event,omitempty"`
+	UpdatedAt        *time.Time       `json:"updated_at,omitempty"`
+	DueDate          *Date            `json:"due_date,omitempty"`
+	Weight           *int             `json:"weight,omitempty"`
+	DiscussionLocked *bool            `json:"discussion_locked,omitempty"`
+	EpicID           *int             `json:"epic_id,omitempty"`
+	IssueType        *string          `json:"issue_type,omitempty"`
+}
+
+// UpdateIssue updates an issue. Set StateEvent to close or reopen it.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-an-issue
+func (s *IssuesService) UpdateIssue(ctx context.Context, pid string, iid int, opts *UpdateIssueOptions) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d", pid, iid)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteIssue deletes an issue. Only administrators and project owners can
+// delete issues.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#delete-an-issue
+func (s *IssuesService) DeleteIssue(ctx context.Context, pid string, iid int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d", pid, iid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MoveIssueOptions represents the available MoveIssue() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#move-an-issue
+type MoveIssueOptions struct {
+	ToProjectID *int `json:"to_project_id,omitempty"`
+}
+
+// MoveIssue moves an issue to another project. The original issue is
+// closed and its MovedToID set.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#move-an-issue
+func (s *IssuesService) MoveIssue(ctx context.Context, pid string, iid int, opts *MoveIssueOptions) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/move", pid, iid)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CloneIssueOptions represents the available CloneIssue() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#clone-an-issue
+type CloneIssueOptions struct {
+	ToProjectID *int  `json:"to_project_id,omitempty"`
+	WithNotes   *bool `json:"with_notes,omitempty"`
+}
+
+// CloneIssue copies an issue to another project, leaving the original open.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#clone-an-issue
+func (s *IssuesService) CloneIssue(ctx context.Context, pid string, iid int, opts *CloneIssueOptions) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/clone", pid, iid)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ReorderIssueOptions represents the available ReorderIssue() options. The
+// IDs are global issue IDs, not IIDs.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#reorder-an-issue
+type ReorderIssueOptions struct {
+	MoveAfterID  *int `json:"move_after_id,omitempty"`
+	MoveBeforeID *int `json:"move_before_id,omitempty"`
+}
+
+// ReorderIssue changes the position of an issue in manually sorted lists
+// and boards.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#reorder-an-issue
+func (s *IssuesService) ReorderIssue(ctx context.Context, pid string, iid int, opts *ReorderIssueOptions) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/reorder", pid, iid)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SubscribeToIssue subscribes the current user to an issue. GitLab answers
+// 304 Not Modified when the user is already subscribed; that is not an
+// error, and the issue is fetched again instead.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#subscribe-to-an-issue
+func (s *IssuesService) SubscribeToIssue(ctx context.Context, pid string, iid int) (*Issue, error) {
+	return s.subscription(ctx, pid, iid, "subscribe")
+}
+
+// UnsubscribeFromIssue unsubscribes the current user from an issue. GitLab
+// answers 304 Not Modified when the user is not subscribed; that is not an
+// error, and the issue is fetched again instead.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#unsubscribe-from-an-issue
+func (s *IssuesService) UnsubscribeFromIssue(ctx context.Context, pid string, iid int) (*Issue, error) {
+	return s.subscription(ctx, pid, iid, "unsubscribe")
+}
+
+func (s *IssuesService) subscription(ctx context.Context, pid string, iid int, action string) (*Issue, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/%s", pid, iid, action)
+	var v Issue
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, &v); err != nil {
+		if code, ok := StatusForErr(err); ok && code == http.StatusNotModified {
+			return s.GetIssue(ctx, pid, iid)
+		}
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ListMergeRequestsRelatedToIssue gets the merge requests that mention an
+// issue or are related to it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#list-merge-requests-related-to-issue
+func (s *IssuesService) ListMergeRequestsRelatedToIssue(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[MergeRequest], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/related_merge_requests", pid, iid)
+	var v []*MergeRequest
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListMergeRequestsClosingIssue gets the merge requests that close an issue
+// when merged.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#list-merge-requests-that-close-a-particular-issue-on-merge
+func (s *IssuesService) ListMergeRequestsClosingIssue(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[MergeRequest], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/issues/%d/closed_by", pid, iid)
+	var v []*MergeRequest
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
@@ -0,0 +1,76 @@
+package gitlab_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestIssuesService_ListProjectIssues(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/api/v4/projects/1/issues"; got != want {
+			t.Errorf("got path %q, want %q", got, want)
+		}
+		if got, want := r.URL.RawQuery, "assignee_username%5B%5D=alice&confidential=false&due_date=overdue&iids%5B%5D=7&in=title&issue_type=incident&labels=bug%2Cp1&search=crash&state=opened"; got != want {
+			t.Errorf("got query %q, want %q", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"iid":7,"title":"Crash","due_date":"2026-01-31","labels":["bug","p1"]}]`))
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	issues, err := client.Issues.ListProjectIssues(context.Background(), "1", &gitlab.ListIssuesOptions{
+		State:            ptr.Ptr("opened"),
+		Labels:           &gitlab.Labels{"bug", "p1"},
+		AssigneeUsername: &[]string{"alice"},
+		IIDs:             &[]int{7},
+		Search:           ptr.Ptr("crash"),
+		In:               ptr.Ptr("title"),
+		Confidential:     ptr.Ptr(false),
+		IssueType:        ptr.Ptr("incident"),
+		DueDate:          ptr.Ptr("overdue"),
+	})
+	if err != nil {
+		t.Fatalf("Issues.ListProjectIssues returned error: %v", err)
+	}
+	if len(issues.Records) != 1 {
+		t.Fatalf("got %d issues, want 1", len(issues.Records))
+	}
+	if issue := issues.Records[0]; issue.IID != 7 || issue.DueDate.String() != "2026-01-31" || len(issue.Labels) != 2 {
+		t.Errorf("unexpected issue: %+v", issue)
+	}
+}
+
+func TestIssuesService_SubscribeToIssue_NotModified(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v4/projects/1/issues/7/subscribe", "POST /api/v4/projects/1/issues/7/unsubscribe":
+			w.WriteHeader(http.StatusNotModified)
+		case "GET /api/v4/projects/1/issues/7":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"iid":7,"subscribed":true}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	issue, err := client.Issues.SubscribeToIssue(context.Background(), "1", 7)
+	if err != nil {
+		t.Fatalf("Issues.SubscribeToIssue returned error: %v", err)
+	}
+	if issue.IID != 7 || !issue.Subscribed {
+		t.Errorf("unexpected issue: %+v", issue)
+	}
+	if _, err = client.Issues.UnsubscribeFromIssue(context.Background(), "1", 7); err != nil {
+		t.Fatalf("Issues.UnsubscribeFromIssue returned error: %v", err)
+	}
+}
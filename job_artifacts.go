@@ -0,0 +1,198 @@
+package gitlab
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// DownloadArtifactsFileOptions represents the available
+// DownloadArtifactsFileByRef() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#download-the-artifacts-archive
+type DownloadArtifactsFileOptions struct {
+	Job *string `query:"job,omitempty"`
+	// Only honored with a CI_JOB_TOKEN; search the most recent successful
+	// pipelines of the ref, not only the latest one.
+	SearchRecentSuccessfulPipelines *bool `query:"search_recent_successful_pipelines,omitempty"`
+}
+
+// DownloadArtifactsFile streams the artifacts zip archive of a job. The
+// caller must close it; see OpenArtifactsArchive to read its entries.
+//
+// Like the other job artifact endpoints, it accepts a CI_JOB_TOKEN
+// (TokenCredential with JobToken) in addition to the usual credentials.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#get-job-artifacts
+func (s *JobsService) DownloadArtifactsFile(ctx context.Context, pid string, jobID int) (io.ReadCloser, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/%d/artifacts", pid, jobID)
+	return s.download(ctx, apiEndpoint, nil)
+}
+
+// DownloadArtifactsFileByRef streams the artifacts zip archive of the latest
+// successful pipeline job with the given name on a ref. The caller must
+// close it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#download-the-artifacts-archive
+func (s *JobsService) DownloadArtifactsFileByRef(ctx context.Context, pid, refName string, opts *DownloadArtifactsFileOptions) (io.ReadCloser, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", pid, url.PathEscape(refName))
+	return s.download(ctx, apiEndpoint, opts)
+}
+
+// DownloadSingleArtifactsFile streams a single file from the artifacts of a
+// job without downloading the whole archive. The caller must close it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#download-a-single-artifact-file-by-job-id
+func (s *JobsService) DownloadSingleArtifactsFile(ctx context.Context, pid string, jobID int, artifactPath string) (io.ReadCloser, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/%d/artifacts/%s", pid, jobID, escapeArtifactPath(artifactPath))
+	return s.download(ctx, apiEndpoint, nil)
+}
+
+// DownloadSingleArtifactsFileByRef streams a single file from the artifacts
+// of the latest successful pipeline job with the given name on a ref. The
+// caller must close it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#download-a-single-artifact-file-from-specific-tag-or-branch
+func (s *JobsService) DownloadSingleArtifactsFileByRef(ctx context.Context, pid, refName, artifactPath string, opts *DownloadArtifactsFileOptions) (io.ReadCloser, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/artifacts/%s/raw/%s", pid, url.PathEscape(refName), escapeArtifactPath(artifactPath))
+	return s.download(ctx, apiEndpoint, opts)
+}
+
+// escapeArtifactPath escapes each segment of a path inside the artifacts
+// archive, keeping the slashes that separate them.
+func escapeArtifactPath(artifactPath string) string {
+	segments := strings.Split(artifactPath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func (s *JobsService) download(ctx context.Context, apiEndpoint string, opts any) (io.ReadCloser, error) {
+	resp, err := s.client.StreamWithCredential(ctx, http.MethodGet, apiEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// KeepArtifacts prevents the artifacts of a job from being deleted when
+// they expire.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#keep-artifacts
+func (s *JobsService) KeepArtifacts(ctx context.Context, pid string, jobID int) (*Job, error) {
+	return s.jobAction(ctx, pid, jobID, "artifacts/keep", nil)
+}
+
+// DeleteArtifacts deletes the artifacts of a job.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#delete-job-artifacts
+func (s *JobsService) DeleteArtifacts(ctx context.Context, pid string, jobID int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/%d/artifacts", pid, jobID)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteProjectArtifacts deletes the artifacts of all jobs in a project
+// that are eligible for deletion.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#delete-project-artifacts
+func (s *JobsService) DeleteProjectArtifacts(ctx context.Context, pid string) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/artifacts", pid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ArtifactsArchive is a job artifacts zip archive opened for reading.
+//
+// A zip archive keeps its index at the end, so it cannot be read from a
+// stream. OpenArtifactsArchive spools the stream to a temporary file instead
+// of memory; Close removes it.
+type ArtifactsArchive struct {
+	*zip.Reader
+
+	file   *os.File
+	remove bool
+}
+
+// OpenArtifactsArchive opens an artifacts archive, typically the stream
+// returned by DownloadArtifactsFile. An *os.File is read in place; any other
+// reader is copied to a temporary file first. The caller must Close the
+// archive and remains responsible for closing r.
+func OpenArtifactsArchive(r io.Reader) (*ArtifactsArchive, error) {
+	if f, ok := r.(*os.File); ok {
+		if info, err := f.Stat(); err == nil && info.Mode().IsRegular() {
+			zr, err := zip.NewReader(f, info.Size())
+			if err != nil {
+				return nil, err
+			}
+			return &ArtifactsArchive{Reader: zr, file: f}, nil
+		}
+	}
+
+	f, err := os.CreateTemp("", "gitlab-artifacts-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	a := &ArtifactsArchive{file: f, remove: true}
+
+	size, err := io.Copy(f, r)
+	if err != nil {
+		_ = a.Close()
+		return nil, err
+	}
+	if a.Reader, err = zip.NewReader(f, size); err != nil {
+		_ = a.Close()
+		return nil, err
+	}
+	return a, nil
+}
+
+// ErrSkipArchive stops Walk early without an error.
+var ErrSkipArchive = errors.New("skip archive")
+
+// Walk calls fn for every entry of the archive in order. Entry contents are
+// read lazily with f.Open. If fn returns ErrSkipArchive, Walk stops and
+// returns nil.
+func (a *ArtifactsArchive) Walk(fn func(f *zip.File) error) error {
+	for _, f := range a.File {
+		if err := fn(f); err != nil {
+			if errors.Is(err, ErrSkipArchive) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Close releases the archive and removes its temporary file, if any.
+func (a *ArtifactsArchive) Close() error {
+	if a.file == nil || !a.remove {
+		return nil
+	}
+	err := a.file.Close()
+	if rmErr := os.Remove(a.file.Name()); err == nil {
+		err = rmErr
+	}
+	a.file = nil
+	return err
+}
@@ -0,0 +1,186 @@
+package gitlab_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nexuer/go-gitlab"
+)
+
+func TestOpenArtifactsArchive(t *testing.T) {
+	files := map[string]string{
+		"coverage/index.html": "<html></html>",
+		"junit.xml":           "<testsuites/>",
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := gitlab.OpenArtifactsArchive(io.NopCloser(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer archive.Close()
+
+	seen := 0
+	err = archive.Walk(func(f *zip.File) error {
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		b, err := io.ReadAll(rc)
+		if err != nil {
+			return err
+		}
+		if string(b) != files[f.Name] {
+			t.Errorf("%s: got %q, want %q", f.Name, b, files[f.Name])
+		}
+		seen++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seen != len(files) {
+		t.Errorf("walked %d entries, want %d", seen, len(files))
+	}
+}
+
+func TestJobsService_DownloadArtifactsFile_Slow(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("first,"))
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte("second"))
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"},
+		&gitlab.Options{Timeout: 50 * time.Millisecond})
+	rc, err := client.Jobs.DownloadArtifactsFile(context.Background(), "1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("stream was cut by the client timeout: %v", err)
+	}
+	if string(b) != "first,second" {
+		t.Errorf("got %q", b)
+	}
+}
+
+func TestJobsService_DownloadSingleArtifactsFile(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(client *gitlab.Client) (io.ReadCloser, error)
+		want string
+	}{
+		{
+			name: "by job",
+			call: func(client *gitlab.Client) (io.ReadCloser, error) {
+				return client.Jobs.DownloadSingleArtifactsFile(context.Background(), "1", 2, "coverage/my report#1.html")
+			},
+			want: "/api/v4/projects/1/jobs/2/artifacts/coverage/my%20report%231.html",
+		},
+		{
+			name: "by ref",
+			call: func(client *gitlab.Client) (io.ReadCloser, error) {
+				return client.Jobs.DownloadSingleArtifactsFileByRef(context.Background(), "1", "main", "coverage/my report#1.html", nil)
+			},
+			want: "/api/v4/projects/1/jobs/artifacts/main/raw/coverage/my%20report%231.html",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.EscapedPath()
+				_, _ = w.Write([]byte("<html></html>"))
+			}))
+			defer srv.Close()
+
+			client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+			rc, err := tt.call(client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = rc.Close()
+			if got != tt.want {
+				t.Errorf("got path %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobsService_DownloadArtifactsFile_Redirect(t *testing.T) {
+	tests := []struct {
+		name      string
+		tokenType gitlab.TokenType
+		header    string
+	}{
+		{name: "private token", tokenType: gitlab.PrivateToken, header: "PRIVATE-TOKEN"},
+		{name: "job token", tokenType: gitlab.JobToken, header: "JOB-TOKEN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var leaked string
+			storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				leaked = r.Header.Get(tt.header)
+				_, _ = w.Write([]byte("zip"))
+			}))
+			defer storage.Close()
+
+			var sameHost string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v4/projects/1/jobs/2/artifacts":
+					http.Redirect(w, r, "/api/v4/projects/1/jobs/2/artifacts/moved", http.StatusFound)
+				case "/api/v4/projects/1/jobs/2/artifacts/moved":
+					sameHost = r.Header.Get(tt.header)
+					http.Redirect(w, r, storage.URL+"/bucket/artifacts.zip", http.StatusFound)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer srv.Close()
+
+			client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, TokenType: tt.tokenType, AccessToken: "token"})
+			rc, err := client.Jobs.DownloadArtifactsFile(context.Background(), "1", 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rc.Close()
+			b, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != "zip" {
+				t.Errorf("got %q", b)
+			}
+			if sameHost != "token" {
+				t.Errorf("token dropped on same-host redirect: %q", sameHost)
+			}
+			if leaked != "" {
+				t.Errorf("token forwarded to another host: %q", leaked)
+			}
+		})
+	}
+}
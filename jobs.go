@@ -0,0 +1,299 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// JobsService handles communication with the ci builds related methods
+// of the GitLab API.
+//
+// The job artifact methods also accept a CI_JOB_TOKEN (TokenCredential with
+// JobToken); the other methods need a user credential.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/jobs.html
+type JobsService service
+
+// Job represents a ci build.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/jobs.html
+type Job struct {
+	ID                int             `json:"id"`
+	Name              string          `json:"name"`
+	Stage             string          `json:"stage"`
+	Status            BuildStateValue `json:"status"`
+	Ref               string          `json:"ref"`
+	Tag               bool            `json:"tag"`
+	Coverage          float64         `json:"coverage"`
+	AllowFailure      bool            `json:"allow_failure"`
+	FailureReason     string          `json:"failure_reason"`
+	CreatedAt         *time.Time      `json:"created_at"`
+	StartedAt         *time.Time      `json:"started_at"`
+	FinishedAt        *time.Time      `json:"finished_at"`
+	ErasedAt          *time.Time      `json:"erased_at"`
+	Duration          float64         `json:"duration"`
+	QueuedDuration    float64         `json:"queued_duration"`
+	ArtifactsExpireAt *time.Time      `json:"artifacts_expire_at"`
+	TagList           []string        `json:"tag_list"`
+	User              *User           `json:"user"`
+	Commit            *Commit         `json:"commit"`
+	Pipeline          *PipelineInfo   `json:"pipeline"`
+	Runner            *JobRunner      `json:"runner"`
+	WebURL            string          `json:"web_url"`
+	Archived          bool            `json:"archived"`
+	Artifacts         []*JobArtifact  `json:"artifacts"`
+	ArtifactsFile     struct {
+		Filename string `json:"filename"`
+		Size     int    `json:"size"`
+	} `json:"artifacts_file"`
+	Project struct {
+		CIJobTokenScopeEnabled bool `json:"ci_job_token_scope_enabled"`
+	} `json:"project"`
+}
+
+// JobArtifact represents a single artifact of a job.
+type JobArtifact struct {
+	FileType   string `json:"file_type"`
+	Filename   string `json:"filename"`
+	Size       int    `json:"size"`
+	FileFormat string `json:"file_format"`
+}
+
+// JobRunner represents the runner that picked up a job.
+type JobRunner struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Active      bool   `json:"active"`
+	Paused      bool   `json:"paused"`
+	IsShared    bool   `json:"is_shared"`
+	RunnerType  string `json:"runner_type"`
+}
+
+// ListJobsOptions represents the available ListProjectJobs() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#list-project-jobs
+type ListJobsOptions struct {
+	ListOptions `query:",inline"`
+
+	Scope *[]BuildStateValue `query:"scope[],omitempty"`
+}
+
+// ListProjectJobs gets a list of jobs in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#list-project-jobs
+func (s *JobsService) ListProjectJobs(ctx context.Context, pid string, opts *ListJobsOptions) (*Records[Job], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs", pid)
+	var v []*Job
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListPipelineJobsOptions represents the available ListPipelineJobs()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#list-pipeline-jobs
+type ListPipelineJobsOptions struct {
+	ListOptions `query:",inline"`
+
+	Scope          *[]BuildStateValue `query:"scope[],omitempty"`
+	IncludeRetried *bool              `query:"include_retried,omitempty"`
+}
+
+// ListPipelineJobs gets a list of jobs for a pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#list-pipeline-jobs
+func (s *JobsService) ListPipelineJobs(ctx context.Context, pid string, pipeline int, opts *ListPipelineJobsOptions) (*Records[Job], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines/%d/jobs", pid, pipeline)
+	var v []*Job
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetJob gets a single job of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#get-a-single-job
+func (s *JobsService) GetJob(ctx context.Context, pid string, jobID int) (*Job, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/%d", pid, jobID)
+	var v Job
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RetryJob retries a single job of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#retry-a-job
+func (s *JobsService) RetryJob(ctx context.Context, pid string, jobID int) (*Job, error) {
+	return s.jobAction(ctx, pid, jobID, "retry", nil)
+}
+
+// CancelJob cancels a single job of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#cancel-a-job
+func (s *JobsService) CancelJob(ctx context.Context, pid string, jobID int) (*Job, error) {
+	return s.jobAction(ctx, pid, jobID, "cancel", nil)
+}
+
+// EraseJob erases a single job of a project, removing its artifacts and
+// trace.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#erase-a-job
+func (s *JobsService) EraseJob(ctx context.Context, pid string, jobID int) (*Job, error) {
+	return s.jobAction(ctx, pid, jobID, "erase", nil)
+}
+
+// JobVariableOptions represents a single job variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#run-a-job
+type JobVariableOptions struct {
+	Key          *string            `json:"key,omitempty"`
+	Value        *string            `json:"value,omitempty"`
+	VariableType *VariableTypeValue `json:"variable_type,omitempty"`
+}
+
+// PlayJobOptions represents the available PlayJob() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#run-a-job
+type PlayJobOptions struct {
+	JobVariablesAttributes *[]*JobVariableOptions `json:"job_variables_attributes,omitempty"`
+}
+
+// PlayJob triggers a manual action to start a job.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#run-a-job
+func (s *JobsService) PlayJob(ctx context.Context, pid string, jobID int, opts *PlayJobOptions) (*Job, error) {
+	return s.jobAction(ctx, pid, jobID, "play", opts)
+}
+
+func (s *JobsService) jobAction(ctx context.Context, pid string, jobID int, action string, opts any) (*Job, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/%d/%s", pid, jobID, action)
+	var v Job
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetTraceFile gets the log (trace) of a job as a stream. The caller must
+// close it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#get-a-log-file
+func (s *JobsService) GetTraceFile(ctx context.Context, pid string, jobID int) (io.ReadCloser, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/%d/trace", pid, jobID)
+	resp, err := s.client.StreamWithCredential(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+const defaultFollowTraceInterval = 2 * time.Second
+
+// FollowJobTraceOptions represents the available FollowJobTrace() options.
+type FollowJobTraceOptions struct {
+	// Offset is the number of trace bytes already seen. Default: 0.
+	Offset int64
+	// Interval between polls. Default: 2s.
+	Interval time.Duration
+}
+
+// FollowJobTrace tails the trace of a job, like tail -f. It polls the trace
+// with HTTP Range requests from the last offset, writes new output to w and
+// returns the job once it reaches a finished state (see
+// BuildStateValue.IsFinished) and the trace has been drained. A manual job
+// waits for someone to play it, so it is returned as soon as it is seen.
+// Scheduled jobs and jobs that never start are followed until they run, so
+// callers usually bound the wait with a ctx deadline.
+func (s *JobsService) FollowJobTrace(ctx context.Context, pid string, jobID int, w io.Writer, opts *FollowJobTraceOptions) (*Job, error) {
+	var offset int64
+	interval := defaultFollowTraceInterval
+	if opts != nil {
+		offset = opts.Offset
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+	}
+
+	for {
+		// Read the job state before the trace, so output written right
+		// before the job finished is still drained.
+		job, err := s.GetJob(ctx, pid, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := s.readTraceFrom(ctx, pid, jobID, offset, w)
+		offset += n
+		if err != nil {
+			return job, err
+		}
+
+		if job.Status.IsFinished() || job.Status == Manual {
+			return job, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return job, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// readTraceFrom copies the trace from offset to w and returns the number of
+// new bytes written.
+func (s *JobsService) readTraceFrom(ctx context.Context, pid string, jobID int, offset int64, w io.Writer) (int64, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/jobs/%d/trace", pid, jobID)
+	resp, err := s.client.StreamWithCredential(ctx, http.MethodGet, apiEndpoint, nil, func(request *http.Request) error {
+		if offset > 0 {
+			request.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+		}
+		return nil
+	})
+	if err != nil {
+		if code, ok := StatusForErr(err); ok && code == http.StatusRequestedRangeNotSatisfiable {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body := io.Reader(resp.Body)
+	if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+		// The server ignored the Range header and sent the whole trace.
+		if _, err = io.CopyN(io.Discard, body, offset); err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, nil
+			}
+			return 0, err
+		}
+	}
+	return io.Copy(w, body)
+}
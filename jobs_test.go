@@ -0,0 +1,106 @@
+package gitlab_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexuer/go-gitlab"
+)
+
+func TestJobsService_ListPipelineJobs(t *testing.T) {
+	client := gitlab.NewClient(testTokenCredential, &gitlab.Options{Debug: true})
+
+	jobs, err := client.Jobs.ListPipelineJobs(context.Background(), "971", 1, nil)
+	if err != nil {
+		t.Fatalf("Jobs.ListPipelineJobs returned error: %v", err)
+	}
+	for _, job := range jobs.Records {
+		t.Logf("job: %d %s %s\n", job.ID, job.Name, job.Status)
+	}
+}
+
+func TestJobsService_FollowJobTrace(t *testing.T) {
+	chunks := []string{"Running with gitlab-runner\n", "$ make test\n", "ok\nJob succeeded\n"}
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/1/jobs/7":
+			polls++
+			status := gitlab.Running
+			if polls >= len(chunks) {
+				status = gitlab.Success
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"id":7,"status":%q}`, status)
+		case "/api/v4/projects/1/jobs/7/trace":
+			trace := strings.Join(chunks[:polls], "")
+			from := 0
+			if rng := r.Header.Get("Range"); rng != "" {
+				from, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+				if from >= len(trace) {
+					w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+					return
+				}
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", from, len(trace)-1, len(trace)))
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(http.StatusPartialContent)
+			}
+			_, _ = w.Write([]byte(trace[from:]))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	var out strings.Builder
+	job, err := client.Jobs.FollowJobTrace(context.Background(), "1", 7, &out, &gitlab.FollowJobTraceOptions{
+		Interval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != gitlab.Success {
+		t.Errorf("unexpected status: %s", job.Status)
+	}
+	if want := strings.Join(chunks, ""); out.String() != want {
+		t.Errorf("got trace %q, want %q", out.String(), want)
+	}
+}
+
+func TestJobsService_FollowJobTrace_Manual(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/1/jobs/7":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"id":7,"status":%q}`, gitlab.Manual)
+		case "/api/v4/projects/1/jobs/7/trace":
+			w.Header().Set("Content-Type", "text/plain")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var out strings.Builder
+	job, err := client.Jobs.FollowJobTrace(ctx, "1", 7, &out, &gitlab.FollowJobTraceOptions{
+		Interval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != gitlab.Manual {
+		t.Errorf("unexpected status: %s", job.Status)
+	}
+}
@@ -0,0 +1,421 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MergeRequestApprovalsService handles communication with the merge request
+// approvals related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html
+type MergeRequestApprovalsService service
+
+// MergeRequestApprovals represents the approval status of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#single-merge-request-approval
+type MergeRequestApprovals struct {
+	ID                             int                          `json:"id"`
+	IID                            int                          `json:"iid"`
+	ProjectID                      int                          `json:"project_id"`
+	Title                          string                       `json:"title"`
+	Description                    string                       `json:"description"`
+	State                          string                       `json:"state"`
+	CreatedAt                      *time.Time                   `json:"created_at"`
+	UpdatedAt                      *time.Time                   `json:"updated_at"`
+	MergeStatus                    string                       `json:"merge_status"`
+	Approved                       bool                         `json:"approved"`
+	ApprovalsBeforeMerge           int                          `json:"approvals_before_merge"`
+	ApprovalsRequired              int                          `json:"approvals_required"`
+	ApprovalsLeft                  int                          `json:"approvals_left"`
+	RequirePasswordToApprove       bool                         `json:"require_password_to_approve"`
+	ApprovedBy                     []*MergeRequestApprover      `json:"approved_by"`
+	SuggestedApprovers             []*BasicUser                 `json:"suggested_approvers"`
+	Approvers                      []*MergeRequestApprover      `json:"approvers"`
+	ApproverGroups                 []*MergeRequestApproverGroup `json:"approver_groups"`
+	UserHasApproved                bool                         `json:"user_has_approved"`
+	UserCanApprove                 bool                         `json:"user_can_approve"`
+	ApprovalRulesLeft              []*MergeRequestApprovalRule  `json:"approval_rules_left"`
+	HasApprovalRules               bool                         `json:"has_approval_rules"`
+	MergeRequestApproversAvailable bool                         `json:"merge_request_approvers_available"`
+	MultipleApprovalRulesAvailable bool                         `json:"multiple_approval_rules_available"`
+	InvalidApproversRules          []*MergeRequestApprovalRule  `json:"invalid_approvers_rules"`
+}
+
+// MergeRequestApprover represents a user approving, or allowed to approve,
+// a merge request.
+type MergeRequestApprover struct {
+	User *BasicUser `json:"user"`
+}
+
+// MergeRequestApproverGroup represents a group allowed to approve a merge
+// request.
+type MergeRequestApproverGroup struct {
+	Group *Group `json:"group"`
+}
+
+// MergeRequestApprovalRule represents an approval rule of a merge request.
+// SourceRule is the project rule it was copied from, if any. ApprovedBy and
+// Approved are only set by GetApprovalState.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#merge-request-level-mr-approvals
+type MergeRequestApprovalRule struct {
+	ID                   int                  `json:"id"`
+	Name                 string               `json:"name"`
+	RuleType             string               `json:"rule_type"`
+	ReportType           string               `json:"report_type"`
+	EligibleApprovers    []*BasicUser         `json:"eligible_approvers"`
+	ApprovalsRequired    int                  `json:"approvals_required"`
+	SourceRule           *ProjectApprovalRule `json:"source_rule"`
+	Users                []*BasicUser         `json:"users"`
+	Groups               []*Group             `json:"groups"`
+	ContainsHiddenGroups bool                 `json:"contains_hidden_groups"`
+	Section              string               `json:"section"`
+	ApprovedBy           []*BasicUser         `json:"approved_by"`
+	Approved             bool                 `json:"approved"`
+	Overridden           bool                 `json:"overridden"`
+}
+
+// MergeRequestApprovalState represents the approval rules of a merge
+// request together with who approved each of them.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-the-approval-state-of-merge-requests
+type MergeRequestApprovalState struct {
+	ApprovalRulesOverwritten bool                        `json:"approval_rules_overwritten"`
+	Rules                    []*MergeRequestApprovalRule `json:"rules"`
+}
+
+// ProjectApprovalRule represents an approval rule of a project, copied to
+// the merge requests targeting one of its protected branches.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-all-approval-rules-for-project
+type ProjectApprovalRule struct {
+	ID                            int                `json:"id"`
+	Name                          string             `json:"name"`
+	RuleType                      string             `json:"rule_type"`
+	ReportType                    string             `json:"report_type"`
+	EligibleApprovers             []*BasicUser       `json:"eligible_approvers"`
+	ApprovalsRequired             int                `json:"approvals_required"`
+	Users                         []*BasicUser       `json:"users"`
+	Groups                        []*Group           `json:"groups"`
+	ProtectedBranches             []*ProtectedBranch `json:"protected_branches"`
+	AppliesToAllProtectedBranches bool               `json:"applies_to_all_protected_branches"`
+	ContainsHiddenGroups          bool               `json:"contains_hidden_groups"`
+}
+
+// PendingApproval represents an approval rule of a merge request that is
+// not satisfied yet: ApprovalsLeft more approvals are needed, from any of
+// Approvers.
+type PendingApproval struct {
+	Rule          *MergeRequestApprovalRule
+	ApprovalsLeft int
+	Approvers     []*BasicUser
+}
+
+// PendingApprovals answers who still needs to approve the merge request:
+// the unsatisfied rules, each with the eligible users who have not approved
+// yet.
+func (s *MergeRequestApprovalState) PendingApprovals() []*PendingApproval {
+	var pending []*PendingApproval
+	for _, rule := range s.Rules {
+		left := rule.ApprovalsRequired - len(rule.ApprovedBy)
+		if rule.Approved || left <= 0 {
+			continue
+		}
+
+		approved := make(map[int]bool, len(rule.ApprovedBy))
+		for _, u := range rule.ApprovedBy {
+			approved[u.ID] = true
+		}
+		eligible := rule.EligibleApprovers
+		if len(eligible) == 0 {
+			eligible = rule.Users
+		}
+		p := &PendingApproval{Rule: rule, ApprovalsLeft: left}
+		for _, u := range eligible {
+			if !approved[u.ID] {
+				p.Approvers = append(p.Approvers, u)
+			}
+		}
+		pending = append(pending, p)
+	}
+	return pending
+}
+
+// PendingApprovers returns the users who may still approve the merge request
+// to satisfy one of its pending rules (see PendingApprovals), each once.
+func (s *MergeRequestApprovalState) PendingApprovers() []*BasicUser {
+	var users []*BasicUser
+	seen := make(map[int]bool)
+	for _, p := range s.PendingApprovals() {
+		for _, u := range p.Approvers {
+			if !seen[u.ID] {
+				seen[u.ID] = true
+				users = append(users, u)
+			}
+		}
+	}
+	return users
+}
+
+// ApproveMergeRequestOptions represents the available ApproveMergeRequest()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
+type ApproveMergeRequestOptions struct {
+	// SHA must match the head of the merge request, so that changes pushed
+	// after the review are not approved by accident.
+	SHA              *string `json:"sha,omitempty"`
+	ApprovalPassword *string `json:"approval_password,omitempty"`
+}
+
+// ApproveMergeRequest approves a merge request as the current user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
+func (s *MergeRequestApprovalsService) ApproveMergeRequest(ctx context.Context, pid string, iid int, opts *ApproveMergeRequestOptions) (*MergeRequestApprovals, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approve", pid, iid)
+	var v MergeRequestApprovals
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UnapproveMergeRequest withdraws the approval of the current user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#unapprove-merge-request
+func (s *MergeRequestApprovalsService) UnapproveMergeRequest(ctx context.Context, pid string, iid int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/unapprove", pid, iid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetApprovals gets the approval status of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#single-merge-request-approval
+func (s *MergeRequestApprovalsService) GetApprovals(ctx context.Context, pid string, iid int) (*MergeRequestApprovals, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approvals", pid, iid)
+	var v MergeRequestApprovals
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetApprovalState gets the approval rules of a merge request and who
+// approved each of them.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-the-approval-state-of-merge-requests
+func (s *MergeRequestApprovalsService) GetApprovalState(ctx context.Context, pid string, iid int) (*MergeRequestApprovalState, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approval_state", pid, iid)
+	var v MergeRequestApprovalState
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ListMergeRequestApprovalRules gets the approval rules of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-merge-request-level-rules
+func (s *MergeRequestApprovalsService) ListMergeRequestApprovalRules(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[MergeRequestApprovalRule], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules", pid, iid)
+	var v []*MergeRequestApprovalRule
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestApprovalRule gets a single approval rule of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-a-single-merge-request-level-rule
+func (s *MergeRequestApprovalsService) GetMergeRequestApprovalRule(ctx context.Context, pid string, iid, rule int) (*MergeRequestApprovalRule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules/%d", pid, iid, rule)
+	var v MergeRequestApprovalRule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateMergeRequestApprovalRuleOptions represents the available
+// CreateMergeRequestApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-merge-request-level-rule
+type CreateMergeRequestApprovalRuleOptions struct {
+	Name                  *string   `json:"name,omitempty"`
+	ApprovalsRequired     *int      `json:"approvals_required,omitempty"`
+	ApprovalProjectRuleID *int      `json:"approval_project_rule_id,omitempty"`
+	UserIDs               *[]int    `json:"user_ids,omitempty"`
+	GroupIDs              *[]int    `json:"group_ids,omitempty"`
+	Usernames             *[]string `json:"usernames,omitempty"`
+}
+
+// CreateMergeRequestApprovalRule creates an approval rule on a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-merge-request-level-rule
+func (s *MergeRequestApprovalsService) CreateMergeRequestApprovalRule(ctx context.Context, pid string, iid int, opts *CreateMergeRequestApprovalRuleOptions) (*MergeRequestApprovalRule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules", pid, iid)
+	var v MergeRequestApprovalRule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateMergeRequestApprovalRuleOptions represents the available
+// UpdateMergeRequestApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-merge-request-level-rule
+type UpdateMergeRequestApprovalRuleOptions struct {
+	Name               *string   `json:"name,omitempty"`
+	ApprovalsRequired  *int      `json:"approvals_required,omitempty"`
+	UserIDs            *[]int    `json:"user_ids,omitempty"`
+	GroupIDs           *[]int    `json:"group_ids,omitempty"`
+	Usernames          *[]string `json:"usernames,omitempty"`
+	RemoveHiddenGroups *bool     `json:"remove_hidden_groups,omitempty"`
+}
+
+// UpdateMergeRequestApprovalRule updates an approval rule of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-merge-request-level-rule
+func (s *MergeRequestApprovalsService) UpdateMergeRequestApprovalRule(ctx context.Context, pid string, iid, rule int, opts *UpdateMergeRequestApprovalRuleOptions) (*MergeRequestApprovalRule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules/%d", pid, iid, rule)
+	var v MergeRequestApprovalRule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteMergeRequestApprovalRule deletes an approval rule of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#delete-merge-request-level-rule
+func (s *MergeRequestApprovalsService) DeleteMergeRequestApprovalRule(ctx context.Context, pid string, iid, rule int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules/%d", pid, iid, rule)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListProjectApprovalRules gets the approval rules of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-all-approval-rules-for-project
+func (s *MergeRequestApprovalsService) ListProjectApprovalRules(ctx context.Context, pid string, opts *ListOptions) (*Records[ProjectApprovalRule], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/approval_rules", pid)
+	var v []*ProjectApprovalRule
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetProjectApprovalRule gets a single approval rule of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-a-single-approval-rule-for-project
+func (s *MergeRequestApprovalsService) GetProjectApprovalRule(ctx context.Context, pid string, rule int) (*ProjectApprovalRule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/approval_rules/%d", pid, rule)
+	var v ProjectApprovalRule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateProjectApprovalRuleOptions represents the available
+// CreateProjectApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
+type CreateProjectApprovalRuleOptions struct {
+	Name                          *string   `json:"name,omitempty"`
+	ApprovalsRequired             *int      `json:"approvals_required,omitempty"`
+	RuleType                      *string   `json:"rule_type,omitempty"`
+	ReportType                    *string   `json:"report_type,omitempty"`
+	UserIDs                       *[]int    `json:"user_ids,omitempty"`
+	GroupIDs                      *[]int    `json:"group_ids,omitempty"`
+	Usernames                     *[]string `json:"usernames,omitempty"`
+	ProtectedBranchIDs            *[]int    `json:"protected_branch_ids,omitempty"`
+	AppliesToAllProtectedBranches *bool     `json:"applies_to_all_protected_branches,omitempty"`
+}
+
+// CreateProjectApprovalRule creates an approval rule on a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
+func (s *MergeRequestApprovalsService) CreateProjectApprovalRule(ctx context.Context, pid string, opts *CreateProjectApprovalRuleOptions) (*ProjectApprovalRule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/approval_rules", pid)
+	var v ProjectApprovalRule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateProjectApprovalRuleOptions represents the available
+// UpdateProjectApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-project-level-rule
+type UpdateProjectApprovalRuleOptions struct {
+	Name                          *string   `json:"name,omitempty"`
+	ApprovalsRequired             *int      `json:"approvals_required,omitempty"`
+	UserIDs                       *[]int    `json:"user_ids,omitempty"`
+	GroupIDs                      *[]int    `json:"group_ids,omitempty"`
+	Usernames                     *[]string `json:"usernames,omitempty"`
+	ProtectedBranchIDs            *[]int    `json:"protected_branch_ids,omitempty"`
+	AppliesToAllProtectedBranches *bool     `json:"applies_to_all_protected_branches,omitempty"`
+	RemoveHiddenGroups            *bool     `json:"remove_hidden_groups,omitempty"`
+}
+
+// UpdateProjectApprovalRule updates an approval rule of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-project-level-rule
+func (s *MergeRequestApprovalsService) UpdateProjectApprovalRule(ctx context.Context, pid string, rule int, opts *UpdateProjectApprovalRuleOptions) (*ProjectApprovalRule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/approval_rules/%d", pid, rule)
+	var v ProjectApprovalRule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteProjectApprovalRule deletes an approval rule of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#delete-project-level-rule
+func (s *MergeRequestApprovalsService) DeleteProjectApprovalRule(ctx context.Context, pid string, rule int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/approval_rules/%d", pid, rule)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package gitlab
+
+import (
+	"testing"
+)
+
+func TestMergeRequestApprovalState_PendingApprovers(t *testing.T) {
+	alice := &BasicUser{ID: 1, Username: "alice"}
+	bob := &BasicUser{ID: 2, Username: "bob"}
+	carol := &BasicUser{ID: 3, Username: "carol"}
+
+	state := &MergeRequestApprovalState{Rules: []*MergeRequestApprovalRule{
+		{
+			Name:              "backend",
+			ApprovalsRequired: 2,
+			EligibleApprovers: []*BasicUser{alice, bob, carol},
+			ApprovedBy:        []*BasicUser{alice},
+		},
+		{
+			Name:              "security",
+			ApprovalsRequired: 1,
+			Users:             []*BasicUser{bob},
+		},
+		{
+			Name:              "docs",
+			ApprovalsRequired: 1,
+			EligibleApprovers: []*BasicUser{carol},
+			ApprovedBy:        []*BasicUser{carol},
+			Approved:          true,
+		},
+		{
+			Name:              "optional",
+			EligibleApprovers: []*BasicUser{alice},
+		},
+	}}
+
+	pending := state.PendingApprovals()
+	if len(pending) != 2 {
+		t.Fatalf("got %d pending rules, want 2", len(pending))
+	}
+	if p := pending[0]; p.Rule.Name != "backend" || p.ApprovalsLeft != 1 || len(p.Approvers) != 2 {
+		t.Errorf("unexpected pending rule: %+v", p)
+	}
+	if p := pending[1]; p.Rule.Name != "security" || p.ApprovalsLeft != 1 || len(p.Approvers) != 1 {
+		t.Errorf("unexpected pending rule: %+v", p)
+	}
+
+	var names []string
+	for _, u := range state.PendingApprovers() {
+		names = append(names, u.Username)
+	}
+	if len(names) != 2 || names[0] != "bob" || names[1] != "carol" {
+		t.Errorf("got pending approvers %v, want [bob carol]", names)
+	}
+}
@@ -0,0 +1,211 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrMergeRequestPatchUnavailable is returned by GetMergeRequestPatch
+	// when the web UI answers with something other than the patch, such as
+	// a redirect to the sign-in page.
+	ErrMergeRequestPatchUnavailable = errors.New("gitlab: merge request patch not available")
+)
+
+// MergeRequestDiffVersion represents a version of the diff of a merge
+// request; a new version is created on every push to the source branch.
+// Commits and Diffs are only set by GetMergeRequestDiffVersion.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-merge-request-diff-versions
+type MergeRequestDiffVersion struct {
+	ID             int                 `json:"id"`
+	HeadCommitSHA  string              `json:"head_commit_sha"`
+	BaseCommitSHA  string              `json:"base_commit_sha"`
+	StartCommitSHA string              `json:"start_commit_sha"`
+	CreatedAt      *time.Time          `json:"created_at"`
+	MergeRequestID int                 `json:"merge_request_id"`
+	State          string              `json:"state"`
+	RealSize       string              `json:"real_size"`
+	PatchIDSHA     string              `json:"patch_id_sha"`
+	Commits        []*Commit           `json:"commits"`
+	Diffs          []*MergeRequestDiff `json:"diffs"`
+}
+
+// ListMergeRequestDiffsOptions represents the available
+// ListMergeRequestDiffs() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-diffs
+type ListMergeRequestDiffsOptions struct {
+	ListOptions `query:",inline"`
+
+	Unidiff *bool `query:"unidiff,omitempty"`
+}
+
+// ListMergeRequestDiffs gets a page of the file diffs of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-diffs
+func (s *MergeRequestsService) ListMergeRequestDiffs(ctx context.Context, pid string, iid int, opts *ListMergeRequestDiffsOptions) (*Records[MergeRequestDiff], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/diffs", pid, iid)
+	var v []*MergeRequestDiff
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestChangesOptions represents the available
+// GetMergeRequestChanges() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-single-merge-request-changes
+type GetMergeRequestChangesOptions struct {
+	// AccessRawDiffs reads the diffs from Gitaly, which lifts the size
+	// limits of the database copy.
+	AccessRawDiffs *bool `query:"access_raw_diffs,omitempty"`
+	Unidiff        *bool `query:"unidiff,omitempty"`
+}
+
+// GetMergeRequestChanges gets a merge request with its file diffs in
+// Changes. When GitLab truncates them, it sets Overflow; Changes is then
+// completed from ListMergeRequestDiffs, and Overflow is left as reported.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-single-merge-request-changes
+func (s *MergeRequestsService) GetMergeRequestChanges(ctx context.Context, pid string, iid int, opts *GetMergeRequestChangesOptions) (*MergeRequest, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/changes", pid, iid)
+	var v MergeRequest
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	if !v.Overflow {
+		return &v, nil
+	}
+
+	list := &ListMergeRequestDiffsOptions{ListOptions: NewListOptions(1, MaxPerPage)}
+	if opts != nil {
+		list.Unidiff = opts.Unidiff
+	}
+	var changes []*MergeRequestDiff
+	for {
+		diffs, err := s.ListMergeRequestDiffs(ctx, pid, iid, list)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, diffs.Records...)
+		if diffs.NextPage == 0 {
+			break
+		}
+		list.Page = diffs.NextPage
+	}
+	v.Changes = changes
+	return &v, nil
+}
+
+// ListMergeRequestDiffVersions gets the diff versions of a merge request,
+// newest first.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-merge-request-diff-versions
+func (s *MergeRequestsService) ListMergeRequestDiffVersions(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[MergeRequestDiffVersion], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/versions", pid, iid)
+	var v []*MergeRequestDiffVersion
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestDiffVersionOptions represents the available
+// GetMergeRequestDiffVersion() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-a-single-merge-request-diff-version
+type GetMergeRequestDiffVersionOptions struct {
+	Unidiff *bool `query:"unidiff,omitempty"`
+}
+
+// GetMergeRequestDiffVersion gets a diff version of a merge request, with
+// its commits and diffs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-a-single-merge-request-diff-version
+func (s *MergeRequestsService) GetMergeRequestDiffVersion(ctx context.Context, pid string, iid, version int, opts *GetMergeRequestDiffVersionOptions) (*MergeRequestDiffVersion, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/versions/%d", pid, iid, version)
+	var v MergeRequestDiffVersion
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ListMergeRequestCommits gets the commits of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-single-merge-request-commits
+func (s *MergeRequestsService) ListMergeRequestCommits(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[Commit], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/commits", pid, iid)
+	var v []*Commit
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestRawDiffs streams the diff of a merge request as plain
+// text in git diff format, like the .diff download of the web UI. The
+// caller must close it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#show-merge-request-raw-diffs
+func (s *MergeRequestsService) GetMergeRequestRawDiffs(ctx context.Context, pid string, iid int) (io.ReadCloser, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/raw_diffs", pid, iid)
+	resp, err := s.client.StreamWithCredential(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// GetMergeRequestPatch streams the commits of a merge request as a series
+// of patches in git format-patch format, ready for git am. The caller must
+// close it.
+//
+// The .patch file is served by the web UI, not the API, so on private
+// projects it is only reachable with credentials the web UI accepts.
+// Otherwise GitLab redirects to the sign-in page; since redirects can't be
+// turned off in the underlying client, a redirected or non-text/plain
+// response is rejected with ErrMergeRequestPatchUnavailable.
+func (s *MergeRequestsService) GetMergeRequestPatch(ctx context.Context, pid string, iid int) (io.ReadCloser, error) {
+	mr, err := s.GetMergeRequest(ctx, pid, iid, nil)
+	if err != nil {
+		return nil, err
+	}
+	path, err := s.client.webPath(mr.WebURL)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.streamWithCredential(ctx, http.MethodGet, path+".patch", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Request != nil && resp.Request.Response != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%w: redirected to %s", ErrMergeRequestPatchUnavailable, resp.Request.URL.Redacted())
+	}
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != "text/plain" {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%w: unexpected content type %q", ErrMergeRequestPatchUnavailable, resp.Header.Get("Content-Type"))
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,210 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nexuer/utils/ptr"
+)
+
+const defaultMergePollInterval = 5 * time.Second
+
+var (
+	// ErrRebaseFailed is returned by RebaseMergeRequest when GitLab could
+	// not rebase the merge request, usually because of conflicts.
+	ErrRebaseFailed = errors.New("gitlab: rebase failed")
+	// ErrMergeBlocked is returned by MergeWhenReady when the merge request
+	// cannot be merged without someone acting on it.
+	ErrMergeBlocked = errors.New("gitlab: merge request is blocked")
+	// ErrSHAMismatch is returned by MergeWhenReady when the source branch
+	// moved since its head was checked (409 Conflict).
+	ErrSHAMismatch = errors.New("gitlab: SHA does not match HEAD of source branch")
+	// ErrMergeFailed is returned by MergeWhenReady when GitLab accepted the
+	// merge but failed to perform it (422 Unprocessable Entity).
+	ErrMergeFailed = errors.New("gitlab: merge failed")
+)
+
+// RebaseMergeRequestOptions represents the available RebaseMergeRequest()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#rebase-a-merge-request
+type RebaseMergeRequestOptions struct {
+	SkipCI *bool `json:"skip_ci,omitempty"`
+
+	// Interval between polls of the rebase state. Default: 5s.
+	Interval time.Duration `json:"-"`
+}
+
+// RebaseMergeRequest rebases the source branch of a merge request onto its
+// target branch. The rebase runs in the background; RebaseMergeRequest
+// polls the merge request until it is done and returns it, or an error
+// wrapping ErrRebaseFailed with the merge error reported by GitLab.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#rebase-a-merge-request
+func (s *MergeRequestsService) RebaseMergeRequest(ctx context.Context, pid string, iid int, opts *RebaseMergeRequestOptions) (*MergeRequest, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/rebase", pid, iid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, nil); err != nil {
+		return nil, err
+	}
+
+	interval := defaultMergePollInterval
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+	get := &GetMergeRequestOptions{IncludeRebaseInProgress: ptr.Ptr(true)}
+	for {
+		mr, err := s.GetMergeRequest(ctx, pid, iid, get)
+		if err != nil {
+			return nil, err
+		}
+		if !mr.RebaseInProgress {
+			if mr.MergeError != "" {
+				return mr, fmt.Errorf("%w: %s", ErrRebaseFailed, mr.MergeError)
+			}
+			return mr, nil
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return mr, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// GetMergeRef gets the SHA of the commit GitLab would create by merging the
+// merge request, and updates refs/merge-requests/:iid/merge to it. It fails
+// with 400 Bad Request when the merge request cannot be merged cleanly.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-to-default-merge-ref-path
+func (s *MergeRequestsService) GetMergeRef(ctx context.Context, pid string, iid int) (string, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/merge_ref", pid, iid)
+	var v struct {
+		CommitID string `json:"commit_id"`
+	}
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return "", err
+	}
+	return v.CommitID, nil
+}
+
+// MergeWhenReadyOptions represents the available MergeWhenReady() options.
+type MergeWhenReadyOptions struct {
+	// Accept is passed to AcceptMergeRequest. When its SHA is nil, the head
+	// of the merge request seen when it became mergeable is used, so commits
+	// pushed meanwhile are never merged unchecked.
+	Accept *AcceptMergeRequestOptions
+	// Rebase rebases the merge request when GitLab reports it needs one,
+	// instead of failing with ErrMergeBlocked.
+	Rebase bool
+	// Interval between polls of the merge status. Default: 5s.
+	Interval time.Duration
+}
+
+// MergeWhenReady waits until a merge request is mergeable, then merges it.
+// It polls the detailed merge status while it is pending (see
+// DetailedMergeStatusValue.Mergeable), for example while approvals or the
+// pipeline are missing, and fails with ErrMergeBlocked when it is blocked
+// or its head pipeline failed.
+//
+// The outcomes of AcceptMergeRequest are handled as documented: on 405 the
+// status is polled again, since it may have changed after it was read; 409
+// fails with ErrSHAMismatch and 422 with ErrMergeFailed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-a-merge-request
+func (s *MergeRequestsService) MergeWhenReady(ctx context.Context, pid string, iid int, opts *MergeWhenReadyOptions) (*MergeRequest, error) {
+	if opts == nil {
+		opts = &MergeWhenReadyOptions{}
+	}
+	interval := defaultMergePollInterval
+	if opts.Interval > 0 {
+		interval = opts.Interval
+	}
+
+	for {
+		mr, err := s.GetMergeRequest(ctx, pid, iid, nil)
+		if err != nil {
+			return nil, err
+		}
+		if mr.State == "merged" {
+			return mr, nil
+		}
+		if p := mr.HeadPipeline; p != nil && (p.Status == string(Failed) || p.Status == string(Canceled)) {
+			return mr, fmt.Errorf("%w: head pipeline %d %s", ErrMergeBlocked, p.ID, p.Status)
+		}
+
+		switch mr.DetailedMergeStatus.Mergeable() {
+		case MergeabilityReady:
+			merged, err := s.accept(ctx, pid, iid, mr, opts.Accept)
+			if err == nil {
+				return merged, nil
+			}
+			if code, ok := StatusForErr(err); !ok || code != http.StatusMethodNotAllowed {
+				return mr, err
+			}
+		case MergeabilityBlocked:
+			if mr.DetailedMergeStatus != NeedRebaseMergeStatus || !opts.Rebase {
+				return mr, fmt.Errorf("%w: %s", ErrMergeBlocked, mr.DetailedMergeStatus)
+			}
+			if _, err := s.RebaseMergeRequest(ctx, pid, iid, &RebaseMergeRequestOptions{Interval: interval}); err != nil {
+				return mr, err
+			}
+			continue
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return mr, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// accept merges mr, guarded by its SHA unless opts sets one.
+func (s *MergeRequestsService) accept(ctx context.Context, pid string, iid int, mr *MergeRequest, opts *AcceptMergeRequestOptions) (*MergeRequest, error) {
+	accept := AcceptMergeRequestOptions{}
+	if opts != nil {
+		accept = *opts
+	}
+	if accept.SHA == nil {
+		accept.SHA = ptr.Ptr(mr.SHA)
+	}
+	merged, err := s.AcceptMergeRequest(ctx, pid, iid, &accept)
+	if err != nil {
+		if code, ok := StatusForErr(err); ok {
+			switch code {
+			case http.StatusConflict:
+				return nil, fmt.Errorf("%w: %v", ErrSHAMismatch, err)
+			case http.StatusUnprocessableEntity:
+				return nil, fmt.Errorf("%w: %v", ErrMergeFailed, err)
+			}
+		}
+		return nil, err
+	}
+	return merged, nil
+}
+
+// CancelMergeWhenPipelineSucceeds cancels the auto-merge of a merge request,
+// set by AcceptMergeRequest with MergeWhenPipelineSucceeds. It also takes a
+// merge request off its merge train.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#cancel-merge-when-pipeline-succeeds
+func (s *MergeRequestsService) CancelMergeWhenPipelineSucceeds(ctx context.Context, pid string, iid int) (*MergeRequest, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/cancel_merge_when_pipeline_succeeds", pid, iid)
+	var v MergeRequest
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
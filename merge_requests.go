@@ -14,61 +14,57 @@ import (
 type MergeRequestsService service
 
 type MergeRequest struct {
-	ID                        int                 `json:"id"`
-	IID                       int                 `json:"iid"`
-	TargetBranch              string              `json:"target_branch"`
-	SourceBranch              string              `json:"source_branch"`
-	ProjectID                 int                 `json:"project_id"`
-	Title                     string              `json:"title"`
-	State                     string              `json:"state"`
-	CreatedAt                 time.Time           `json:"created_at"`
-	UpdatedAt                 time.Time           `json:"updated_at"`
-	Upvotes                   int                 `json:"upvotes"`
-	Downvotes                 int                 `json:"downvotes"`
-	Author                    *BasicUser          `json:"author"`
-	Assignee                  *BasicUser          `json:"assignee"`
-	Assignees                 []*BasicUser        `json:"assignees"`
-	Reviewers                 []*BasicUser        `json:"reviewers"`
-	SourceProjectID           int                 `json:"source_project_id"`
-	TargetProjectID           int                 `json:"target_project_id"`
-	Labels                    Labels              `json:"labels"`
-	LabelDetails              []*LabelDetails     `json:"label_details"`
-	Description               string              `json:"description"`
-	Draft                     bool                `json:"draft"`
-	WorkInProgress            bool                `json:"work_in_progress"`
-	Milestone                 *Milestone          `json:"milestone"`
-	MergeWhenPipelineSucceeds bool                `json:"merge_when_pipeline_succeeds"`
-	DetailedMergeStatus       string              `json:"detailed_merge_status"`
-	MergeError                string              `json:"merge_error"`
-	MergedBy                  *BasicUser          `json:"merged_by"`
-	MergedAt                  *time.Time          `json:"merged_at"`
-	ClosedBy                  *BasicUser          `json:"closed_by"`
-	ClosedAt                  *time.Time          `json:"closed_at"`
-	Subscribed                bool                `json:"subscribed"`
-	SHA                       string              `json:"sha"`
-	MergeCommitSHA            string              `json:"merge_commit_sha"`
-	SquashCommitSHA           string              `json:"squash_commit_sha"`
-	UserNotesCount            int                 `json:"user_notes_count"`
-	ChangesCount              string              `json:"changes_count"`
-	ShouldRemoveSourceBranch  bool                `json:"should_remove_source_branch"`
-	ForceRemoveSourceBranch   bool                `json:"force_remove_source_branch"`
-	AllowCollaboration        bool                `json:"allow_collaboration"`
-	WebURL                    string              `json:"web_url"`
-	References                *IssueReferences    `json:"references"`
-	DiscussionLocked          bool                `json:"discussion_locked"`
-	Changes                   []*MergeRequestDiff `json:"changes"`
+	ID                        int                      `json:"id"`
+	IID                       int                      `json:"iid"`
+	TargetBranch              string                   `json:"target_branch"`
+	SourceBranch              string                   `json:"source_branch"`
+	ProjectID                 int                      `json:"project_id"`
+	Title                     string                   `json:"title"`
+	State                     string                   `json:"state"`
+	CreatedAt                 time.Time                `json:"created_at"`
+	UpdatedAt                 time.Time                `json:"updated_at"`
+	Upvotes                   int                      `json:"upvotes"`
+	Downvotes                 int                      `json:"downvotes"`
+	Author                    *BasicUser               `json:"author"`
+	Assignee                  *BasicUser               `json:"assignee"`
+	Assignees                 []*BasicUser             `json:"assignees"`
+	Reviewers                 []*BasicUser             `json:"reviewers"`
+	SourceProjectID           int                      `json:"source_project_id"`
+	TargetProjectID           int                      `json:"target_project_id"`
+	Labels                    Labels                   `json:"labels"`
+	LabelDetails              []*LabelDetails          `json:"label_details"`
+	Description               string                   `json:"description"`
+	Draft                     bool                     `json:"draft"`
+	WorkInProgress            bool                     `json:"work_in_progress"`
+	Milestone                 *Milestone               `json:"milestone"`
+	MergeWhenPipelineSucceeds bool                     `json:"merge_when_pipeline_succeeds"`
+	DetailedMergeStatus       DetailedMergeStatusValue `json:"detailed_merge_status"`
+	MergeError                string                   `json:"merge_error"`
+	MergedBy                  *BasicUser               `json:"merged_by"`
+	MergedAt                  *time.Time               `json:"merged_at"`
+	ClosedBy                  *BasicUser               `json:"closed_by"`
+	ClosedAt                  *time.Time               `json:"closed_at"`
+	Subscribed                bool                     `json:"subscribed"`
+	SHA                       string                   `json:"sha"`
+	MergeCommitSHA            string                   `json:"merge_commit_sha"`
+	SquashCommitSHA           string                   `json:"squash_commit_sha"`
+	UserNotesCount            int                      `json:"user_notes_count"`
+	ChangesCount              string                   `json:"changes_count"`
+	ShouldRemoveSourceBranch  bool                     `json:"should_remove_source_branch"`
+	ForceRemoveSourceBranch   bool                     `json:"force_remove_source_branch"`
+	AllowCollaboration        bool                     `json:"allow_collaboration"`
+	WebURL                    string                   `json:"web_url"`
+	References                *IssueReferences         `json:"references"`
+	DiscussionLocked          bool                     `json:"discussion_locked"`
+	Changes                   []*MergeRequestDiff      `json:"changes"`
 	User                      struct {
 		CanMerge bool `json:"can_merge"`
 	} `json:"user"`
-	TimeStats    *TimeStats    `json:"time_stats"`
-	Squash       bool          `json:"squash"`
-	Pipeline     *PipelineInfo `json:"pipeline"`
-	HeadPipeline *Pipeline     `json:"head_pipeline"`
-	DiffRefs     struct {
-		BaseSha  string `json:"base_sha"`
-		HeadSha  string `json:"head_sha"`
-		StartSha string `json:"start_sha"`
-	} `json:"diff_refs"`
+	TimeStats                   *TimeStats             `json:"time_stats"`
+	Squash                      bool                   `json:"squash"`
+	Pipeline                    *PipelineInfo          `json:"pipeline"`
+	HeadPipeline                *Pipeline              `json:"head_pipeline"`
+	DiffRefs                    DiffRefs               `json:"diff_refs"`
 	DivergedCommitsCount        int                    `json:"diverged_commits_count"`
 	RebaseInProgress            bool                   `json:"rebase_in_progress"`
 	ApprovalsBeforeMerge        int                    `json:"approvals_before_merge"`
@@ -83,6 +79,14 @@ type MergeRequest struct {
 	MergeStatus string `json:"merge_status"`
 }
 
+// DiffRefs represents the commits a merge request diff is computed from.
+// They are needed to position diff notes, see DiffRefs.PositionFor.
+type DiffRefs struct {
+	BaseSha  string `json:"base_sha"`
+	HeadSha  string `json:"head_sha"`
+	StartSha string `json:"start_sha"`
+}
+
 // MergeRequestDiff represents Gitlab merge request diff.
 //
 // Gitlab API docs:
@@ -155,3 +159,189 @@ func (s *MergeRequestsService) AcceptMergeRequest(ctx context.Context, projectId
 	}
 	return v, nil
 }
+
+// ListMergeRequestsOptions represents the available ListMergeRequests() and
+// ListGroupMergeRequests() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-requests
+type ListMergeRequestsOptions struct {
+	ListOptions `query:",inline"`
+
+	// State is one of opened, closed, locked, merged or all.
+	State *string `query:"state,omitempty"`
+	// Scope is one of created_by_me, assigned_to_me or all.
+	Scope                  *string    `query:"scope,omitempty"`
+	View                   *string    `query:"view,omitempty"`
+	Labels                 *Labels    `query:"labels,comma,omitempty"`
+	NotLabels              *Labels    `query:"not[labels],comma,omitempty"`
+	WithLabelsDetails      *bool      `query:"with_labels_details,omitempty"`
+	WithMergeStatusRecheck *bool      `query:"with_merge_status_recheck,omitempty"`
+	Milestone              *string    `query:"milestone,omitempty"`
+	AuthorID               *int       `query:"author_id,omitempty"`
+	AuthorUsername         *string    `query:"author_username,omitempty"`
+	AssigneeID             *int       `query:"assignee_id,omitempty"`
+	ReviewerID             *int       `query:"reviewer_id,omitempty"`
+	ReviewerUsername       *string    `query:"reviewer_username,omitempty"`
+	ApprovedByIDs          *[]int     `query:"approved_by_ids[],omitempty"`
+	MyReactionEmoji        *string    `query:"my_reaction_emoji,omitempty"`
+	SourceBranch           *string    `query:"source_branch,omitempty"`
+	TargetBranch           *string    `query:"target_branch,omitempty"`
+	CreatedAfter           *time.Time `query:"created_after,omitempty"`
+	CreatedBefore          *time.Time `query:"created_before,omitempty"`
+	UpdatedAfter           *time.Time `query:"updated_after,omitempty"`
+	UpdatedBefore          *time.Time `query:"updated_before,omitempty"`
+	Environment            *string    `query:"environment,omitempty"`
+	DeployedAfter          *time.Time `query:"deployed_after,omitempty"`
+	DeployedBefore         *time.Time `query:"deployed_before,omitempty"`
+	Search                 *string    `query:"search,omitempty"`
+	// In is title, description or title,description.
+	In *string `query:"in,omitempty"`
+	// WIP is yes to only list drafts and no to leave them out.
+	WIP *string `query:"wip,omitempty"`
+}
+
+// ListMergeRequests gets a list of the merge requests the user has access
+// to. By default only those created by the user are returned; set Scope to
+// all for every merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-requests
+func (s *MergeRequestsService) ListMergeRequests(ctx context.Context, opts *ListMergeRequestsOptions) (*Records[MergeRequest], error) {
+	var v []*MergeRequest
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, "merge_requests", opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListProjectMergeRequestsOptions represents the available
+// ListProjectMergeRequests() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests
+type ListProjectMergeRequestsOptions struct {
+	ListMergeRequestsOptions `query:",inline"`
+
+	IIDs *[]int `query:"iids[],omitempty"`
+}
+
+// ListProjectMergeRequests gets a list of the merge requests of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests
+func (s *MergeRequestsService) ListProjectMergeRequests(ctx context.Context, pid string, opts *ListProjectMergeRequestsOptions) (*Records[MergeRequest], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests", pid)
+	var v []*MergeRequest
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListGroupMergeRequests gets a list of the merge requests of a group and
+// its subgroups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-group-merge-requests
+func (s *MergeRequestsService) ListGroupMergeRequests(ctx context.Context, gid string, opts *ListMergeRequestsOptions) (*Records[MergeRequest], error) {
+	apiEndpoint := fmt.Sprintf("groups/%s/merge_requests", gid)
+	var v []*MergeRequest
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestOptions represents the available GetMergeRequest() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
+type GetMergeRequestOptions struct {
+	RenderHTML                  *bool `query:"render_html,omitempty"`
+	IncludeDivergedCommitsCount *bool `query:"include_diverged_commits_count,omitempty"`
+	IncludeRebaseInProgress     *bool `query:"include_rebase_in_progress,omitempty"`
+}
+
+// GetMergeRequest gets a single merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
+func (s *MergeRequestsService) GetMergeRequest(ctx context.Context, pid string, iid int, opts *GetMergeRequestOptions) (*MergeRequest, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d", pid, iid)
+	var v MergeRequest
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateMergeRequestOptions represents the available UpdateMergeRequest()
+// options. Labels replaces every label; AddLabels and RemoveLabels change
+// only the given ones. An empty ReviewerIDs list removes every reviewer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+type UpdateMergeRequestOptions struct {
+	Title              *string          `json:"title,omitempty"`
+	Description        *string          `json:"description,omitempty"`
+	TargetBranch       *string          `json:"target_branch,omitempty"`
+	AssigneeID         *int             `json:"assignee_id,omitempty"`
+	AssigneeIDs        *[]int           `json:"assignee_ids,omitempty"`
+	ReviewerIDs        *[]int           `json:"reviewer_ids,omitempty"`
+	Labels             *Labels          `json:"labels,omitempty"`
+	AddLabels          *Labels          `json:"add_labels,omitempty"`
+	RemoveLabels       *Labels          `json:"remove_labels,omitempty"`
+	MilestoneID        *int             `json:"milestone_id,omitempty"`
+	StateEvent         *StateEventValue `json:"state_event,omitempty"`
+	RemoveSourceBranch *bool            `json:"remove_source_branch,omitempty"`
+	Squash             *bool            `json:"squash,omitempty"`
+	DiscussionLocked   *bool            `json:"discussion_locked,omitempty"`
+	AllowCollaboration *bool            `json:"allow_collaboration,omitempty"`
+}
+
+// UpdateMergeRequest updates a merge request. Set StateEvent to close or
+// reopen it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) UpdateMergeRequest(ctx context.Context, pid string, iid int, opts *UpdateMergeRequestOptions) (*MergeRequest, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d", pid, iid)
+	var v MergeRequest
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetIssuesClosedOnMerge gets the issues a merge request closes when
+// merged.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-issues-that-close-on-merge
+func (s *MergeRequestsService) GetIssuesClosedOnMerge(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[Issue], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/closes_issues", pid, iid)
+	var v []*Issue
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListRelatedIssues gets the issues a merge request mentions.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-issues-related-to-the-merge-request
+func (s *MergeRequestsService) ListRelatedIssues(ctx context.Context, pid string, iid int, opts *ListOptions) (*Records[Issue], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/related_issues", pid, iid)
+	var v []*Issue
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
@@ -0,0 +1,188 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestMergeRequestsService_ListProjectMergeRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.RawQuery, "iids%5B%5D=3&iids%5B%5D=4&labels=bug%2Cui&page=2&per_page=50&state=opened&wip=no"; got != want {
+			t.Errorf("got query %q, want %q", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Next-Page", "3")
+		_, _ = w.Write([]byte(`[{"iid":3,"title":"Fix"}]`))
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	mrs, err := client.MergeRequests.ListProjectMergeRequests(context.Background(), "1", &gitlab.ListProjectMergeRequestsOptions{
+		ListMergeRequestsOptions: gitlab.ListMergeRequestsOptions{
+			ListOptions: gitlab.NewListOptions(2, 50),
+			State:       ptr.Ptr("opened"),
+			Labels:      &gitlab.Labels{"bug", "ui"},
+			WIP:         ptr.Ptr("no"),
+		},
+		IIDs: &[]int{3, 4},
+	})
+	if err != nil {
+		t.Fatalf("MergeRequests.ListProjectMergeRequests returned error: %v", err)
+	}
+	if len(mrs.Records) != 1 || mrs.NextPage != 3 {
+		t.Errorf("unexpected records: %+v", mrs)
+	}
+}
+
+func TestMergeRequestsService_MergeWhenReady(t *testing.T) {
+	statuses := []string{"ci_still_running", "not_approved", "mergeable"}
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v4/projects/1/merge_requests/2":
+			status := statuses[min(polls, len(statuses)-1)]
+			polls++
+			_, _ = fmt.Fprintf(w, `{"iid":2,"state":"opened","sha":"abc","detailed_merge_status":%q}`, status)
+		case "PUT /api/v4/projects/1/merge_requests/2/merge":
+			var body gitlab.AcceptMergeRequestOptions
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body.SHA == nil || *body.SHA != "abc" {
+				t.Errorf("merged without the SHA guard: %+v", body)
+			}
+			_, _ = w.Write([]byte(`{"iid":2,"state":"merged"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	mr, err := client.MergeRequests.MergeWhenReady(context.Background(), "1", 2, &gitlab.MergeWhenReadyOptions{
+		Interval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("MergeRequests.MergeWhenReady returned error: %v", err)
+	}
+	if mr.State != "merged" || polls != len(statuses) {
+		t.Errorf("got state %s after %d polls", mr.State, polls)
+	}
+}
+
+func TestMergeRequestsService_MergeWhenReady_Errors(t *testing.T) {
+	tests := []struct {
+		status string
+		code   int
+		want   error
+	}{
+		{status: "conflict", want: gitlab.ErrMergeBlocked},
+		{status: "mergeable", code: http.StatusConflict, want: gitlab.ErrSHAMismatch},
+		{status: "mergeable", code: http.StatusUnprocessableEntity, want: gitlab.ErrMergeFailed},
+	}
+	for _, tt := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method == http.MethodPut {
+				w.WriteHeader(tt.code)
+				_, _ = w.Write([]byte(`{"message":"nope"}`))
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"iid":2,"state":"opened","sha":"abc","detailed_merge_status":%q}`, tt.status)
+		}))
+
+		client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+		_, err := client.MergeRequests.MergeWhenReady(context.Background(), "1", 2, &gitlab.MergeWhenReadyOptions{
+			Interval: time.Millisecond,
+		})
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s/%d: got error %v, want %v", tt.status, tt.code, err, tt.want)
+		}
+		srv.Close()
+	}
+}
+
+func TestMergeRequestsService_GetMergeRequestPatch(t *testing.T) {
+	const patch = "From abc Mon Sep 17 00:00:00 2001\nSubject: [PATCH] fix\n"
+	tests := []struct {
+		name    string
+		private bool
+	}{
+		{name: "public"},
+		{name: "sign-in redirect", private: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var srv *httptest.Server
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v4/projects/1/merge_requests/2":
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = fmt.Fprintf(w, `{"iid":2,"web_url":%q}`, srv.URL+"/group/app/-/merge_requests/2")
+				case "/group/app/-/merge_requests/2.patch":
+					if tt.private {
+						http.Redirect(w, r, "/users/sign_in", http.StatusFound)
+						return
+					}
+					w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+					_, _ = w.Write([]byte(patch))
+				case "/users/sign_in":
+					w.Header().Set("Content-Type", "text/html; charset=utf-8")
+					_, _ = w.Write([]byte("<html>Sign in</html>"))
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer srv.Close()
+
+			client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+			rc, err := client.MergeRequests.GetMergeRequestPatch(context.Background(), "1", 2)
+			if tt.private {
+				if !errors.Is(err, gitlab.ErrMergeRequestPatchUnavailable) {
+					t.Fatalf("got error %v, want %v", err, gitlab.ErrMergeRequestPatchUnavailable)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergeRequests.GetMergeRequestPatch returned error: %v", err)
+			}
+			defer rc.Close()
+			b, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != patch {
+				t.Errorf("got %q, want %q", b, patch)
+			}
+		})
+	}
+}
+
+func TestDetailedMergeStatusValue_Mergeable(t *testing.T) {
+	tests := map[gitlab.DetailedMergeStatusValue]gitlab.MergeabilityValue{
+		gitlab.MergeableMergeStatus:      gitlab.MergeabilityReady,
+		gitlab.CIStillRunningMergeStatus: gitlab.MergeabilityPending,
+		gitlab.NotApprovedMergeStatus:    gitlab.MergeabilityPending,
+		gitlab.NeedRebaseMergeStatus:     gitlab.MergeabilityBlocked,
+		"some_future_check":              gitlab.MergeabilityBlocked,
+	}
+	for status, want := range tests {
+		if got := status.Mergeable(); got != want {
+			t.Errorf("%s: got %s, want %s", status, got, want)
+		}
+	}
+}
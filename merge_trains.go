@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// MergeTrainsService handles communication with the merge trains related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/merge_trains.html
+type MergeTrainsService service
+
+// MergeTrainStatusValue represents the status of a car of a merge train.
+type MergeTrainStatusValue string
+
+// List of available merge train statuses.
+const (
+	IdleMergeTrainStatus       MergeTrainStatusValue = "idle"
+	StaleMergeTrainStatus      MergeTrainStatusValue = "stale"
+	FreshMergeTrainStatus      MergeTrainStatusValue = "fresh"
+	MergingMergeTrainStatus    MergeTrainStatusValue = "merging"
+	MergedMergeTrainStatus     MergeTrainStatusValue = "merged"
+	SkipMergedMergeTrainStatus MergeTrainStatusValue = "skip_merged"
+)
+
+// MergeTrain represents a car of a merge train: a merge request queued to
+// be merged into TargetBranch, with the pipeline testing it on top of the
+// cars ahead of it.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/merge_trains.html
+type MergeTrain struct {
+	ID           int                     `json:"id"`
+	MergeRequest *MergeTrainMergeRequest `json:"merge_request"`
+	User         *BasicUser              `json:"user"`
+	Pipeline     *PipelineInfo           `json:"pipeline"`
+	CreatedAt    *time.Time              `json:"created_at"`
+	UpdatedAt    *time.Time              `json:"updated_at"`
+	TargetBranch string                  `json:"target_branch"`
+	Status       MergeTrainStatusValue   `json:"status"`
+	MergedAt     *time.Time              `json:"merged_at"`
+	Duration     int                     `json:"duration"`
+}
+
+// MergeTrainMergeRequest represents the merge request of a merge train car.
+type MergeTrainMergeRequest struct {
+	ID          int        `json:"id"`
+	IID         int        `json:"iid"`
+	ProjectID   int        `json:"project_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	State       string     `json:"state"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	WebURL      string     `json:"web_url"`
+}
+
+// ListMergeTrainsOptions represents the available ListProjectMergeTrains()
+// and ListMergeTrainsForBranch() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-trains-for-a-project
+type ListMergeTrainsOptions struct {
+	ListOptions `query:",inline"`
+
+	// Scope is active or complete.
+	Scope *string `query:"scope,omitempty"`
+}
+
+// ListProjectMergeTrains gets the merge train cars of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-trains-for-a-project
+func (s *MergeTrainsService) ListProjectMergeTrains(ctx context.Context, pid string, opts *ListMergeTrainsOptions) (*Records[MergeTrain], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_trains", pid)
+	var v []*MergeTrain
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// ListMergeTrainsForBranch gets the cars of the merge train of a target
+// branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-requests-in-a-merge-train
+func (s *MergeTrainsService) ListMergeTrainsForBranch(ctx context.Context, pid, targetBranch string, opts *ListMergeTrainsOptions) (*Records[MergeTrain], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_trains/%s", pid, url.PathEscape(targetBranch))
+	var v []*MergeTrain
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestOnAMergeTrain gets the merge train car of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#get-the-status-of-a-merge-request-on-a-merge-train
+func (s *MergeTrainsService) GetMergeRequestOnAMergeTrain(ctx context.Context, pid string, iid int) (*MergeTrain, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_trains/merge_requests/%d", pid, iid)
+	var v MergeTrain
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// AddMergeRequestToMergeTrainOptions represents the available
+// AddMergeRequestToMergeTrain() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#add-a-merge-request-to-a-merge-train
+type AddMergeRequestToMergeTrainOptions struct {
+	WhenPipelineSucceeds *bool   `json:"when_pipeline_succeeds,omitempty"`
+	SHA                  *string `json:"sha,omitempty"`
+	Squash               *bool   `json:"squash,omitempty"`
+}
+
+// AddMergeRequestToMergeTrain adds a merge request to the merge train of its
+// target branch. With WhenPipelineSucceeds, it joins the train once its
+// pipeline succeeds. The cars of the train are returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#add-a-merge-request-to-a-merge-train
+func (s *MergeTrainsService) AddMergeRequestToMergeTrain(ctx context.Context, pid string, iid int, opts *AddMergeRequestToMergeTrainOptions) ([]*MergeTrain, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_trains/merge_requests/%d", pid, iid)
+	var v []*MergeTrain
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
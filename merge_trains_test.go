@@ -0,0 +1,42 @@
+package gitlab_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestMergeTrainsService_ListMergeTrainsForBranch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.EscapedPath(), "/api/v4/projects/1/merge_trains/release%2F1.0"; got != want {
+			t.Errorf("got path %q, want %q", got, want)
+		}
+		if got, want := r.URL.RawQuery, "scope=active"; got != want {
+			t.Errorf("got query %q, want %q", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"status":"fresh","target_branch":"release/1.0",
+			"merge_request":{"iid":5,"title":"Fix"},"pipeline":{"id":30,"status":"running","ref":"refs/merge-requests/5/train"}}]`))
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	cars, err := client.MergeTrains.ListMergeTrainsForBranch(context.Background(), "1", "release/1.0", &gitlab.ListMergeTrainsOptions{
+		Scope: ptr.Ptr("active"),
+	})
+	if err != nil {
+		t.Fatalf("MergeTrains.ListMergeTrainsForBranch returned error: %v", err)
+	}
+	if len(cars.Records) != 1 {
+		t.Fatalf("got %d cars, want 1", len(cars.Records))
+	}
+	car := cars.Records[0]
+	if car.Status != gitlab.FreshMergeTrainStatus || car.MergeRequest.IID != 5 || car.Pipeline.ID != 30 {
+		t.Errorf("unexpected car: %+v", car)
+	}
+}
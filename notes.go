@@ -0,0 +1,264 @@
+package gitlab
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// NotesService handles communication with the notes related methods of the
+// GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/notes.html
+type NotesService service
+
+// Note represents a GitLab note (comment). Diff notes (Type "DiffNote")
+// carry the Position of the line they are attached to.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/notes.html
+type Note struct {
+	ID           int        `json:"id"`
+	Type         string     `json:"type"`
+	Body         string     `json:"body"`
+	Attachment   string     `json:"attachment"`
+	Title        string     `json:"title"`
+	FileName     string     `json:"file_name"`
+	Author       *BasicUser `json:"author"`
+	System       bool       `json:"system"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	CommitID     string     `json:"commit_id"`
+	Position     *Position  `json:"position"`
+	NoteableID   int        `json:"noteable_id"`
+	NoteableType string     `json:"noteable_type"`
+	NoteableIID  int        `json:"noteable_iid"`
+	ProjectID    int        `json:"project_id"`
+	Resolvable   bool       `json:"resolvable"`
+	Resolved     bool       `json:"resolved"`
+	ResolvedBy   *BasicUser `json:"resolved_by"`
+	ResolvedAt   *time.Time `json:"resolved_at"`
+	Confidential bool       `json:"confidential"`
+	Internal     bool       `json:"internal"`
+}
+
+// PositionTypeValue represents the kind of object a diff note is attached to.
+type PositionTypeValue string
+
+// List of available position types.
+const (
+	TextPositionType  PositionTypeValue = "text"
+	ImagePositionType PositionTypeValue = "image"
+	FilePositionType  PositionTypeValue = "file"
+)
+
+// Position represents the place of a diff note, used both when reading notes
+// and when creating discussions. For text positions, an added line only has
+// NewLine, a removed line only OldLine and an unchanged line both. LineRange
+// extends a text position over several lines, ending at the line of the
+// position itself. Width, Height, X and Y locate image notes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/discussions.html#create-a-new-thread-in-the-merge-request-diff
+type Position struct {
+	BaseSHA      string            `json:"base_sha"`
+	StartSHA     string            `json:"start_sha"`
+	HeadSHA      string            `json:"head_sha"`
+	PositionType PositionTypeValue `json:"position_type"`
+	OldPath      string            `json:"old_path,omitempty"`
+	NewPath      string            `json:"new_path,omitempty"`
+	OldLine      int               `json:"old_line,omitempty"`
+	NewLine      int               `json:"new_line,omitempty"`
+	LineRange    *LineRange        `json:"line_range,omitempty"`
+	Width        int               `json:"width,omitempty"`
+	Height       int               `json:"height,omitempty"`
+	X            float64           `json:"x,omitempty"`
+	Y            float64           `json:"y,omitempty"`
+}
+
+// LineRange represents the lines a multi-line diff note spans.
+type LineRange struct {
+	Start *LinePosition `json:"start"`
+	End   *LinePosition `json:"end"`
+}
+
+// LinePosition represents one end of a LineRange. Type is "new" for added
+// lines and "old" otherwise.
+type LinePosition struct {
+	LineCode string `json:"line_code"`
+	Type     string `json:"type"`
+	OldLine  int    `json:"old_line,omitempty"`
+	NewLine  int    `json:"new_line,omitempty"`
+}
+
+// ErrInvalidPosition is returned when a diff note position cannot be
+// computed from the given diff refs and lines.
+var ErrInvalidPosition = errors.New("gitlab: invalid diff position")
+
+// PositionFor computes the position of a note on a line of a parsed diff
+// (see MergeRequestDiff.Hunks). The diff must belong to the merge request
+// version r comes from, usually MergeRequest.DiffRefs.
+func (r DiffRefs) PositionFor(diff *MergeRequestDiff, line *DiffLine) (*Position, error) {
+	if r.BaseSha == "" || r.StartSha == "" || r.HeadSha == "" {
+		return nil, fmt.Errorf("%w: missing diff refs", ErrInvalidPosition)
+	}
+	if diff == nil || line == nil || (line.OldLine == 0 && line.NewLine == 0) {
+		return nil, fmt.Errorf("%w: missing diff line", ErrInvalidPosition)
+	}
+	return &Position{
+		BaseSHA:      r.BaseSha,
+		StartSHA:     r.StartSha,
+		HeadSHA:      r.HeadSha,
+		PositionType: TextPositionType,
+		OldPath:      diff.OldPath,
+		NewPath:      diff.NewPath,
+		OldLine:      line.OldLine,
+		NewLine:      line.NewLine,
+	}, nil
+}
+
+// RangePositionFor computes the position of a note spanning the lines from
+// start to end of a parsed diff. Both lines must come from the same hunk,
+// start first.
+func (r DiffRefs) RangePositionFor(diff *MergeRequestDiff, start, end *DiffLine) (*Position, error) {
+	pos, err := r.PositionFor(diff, end)
+	if err != nil {
+		return nil, err
+	}
+	if start == nil || (start.OldLine == 0 && start.NewLine == 0) {
+		return nil, fmt.Errorf("%w: missing diff line", ErrInvalidPosition)
+	}
+	if (start.OldLine > 0 && end.OldLine > 0 && start.OldLine > end.OldLine) ||
+		(start.NewLine > 0 && end.NewLine > 0 && start.NewLine > end.NewLine) {
+		return nil, fmt.Errorf("%w: range ends before it starts", ErrInvalidPosition)
+	}
+	path := diff.NewPath
+	if path == "" {
+		path = diff.OldPath
+	}
+	pos.LineRange = &LineRange{
+		Start: newLinePosition(path, start),
+		End:   newLinePosition(path, end),
+	}
+	return pos, nil
+}
+
+func newLinePosition(path string, line *DiffLine) *LinePosition {
+	typ := "old"
+	if line.Type == AddedDiffLine {
+		typ = "new"
+	}
+	return &LinePosition{
+		LineCode: lineCode(path, line.OldLine, line.NewLine),
+		Type:     typ,
+		OldLine:  line.OldLine,
+		NewLine:  line.NewLine,
+	}
+}
+
+// lineCode computes the line code GitLab uses to identify a diff line:
+// the SHA-1 of the file path, then the old and new line numbers.
+func lineCode(path string, oldLine, newLine int) string {
+	sum := sha1.Sum([]byte(path))
+	return fmt.Sprintf("%s_%d_%d", hex.EncodeToString(sum[:]), oldLine, newLine)
+}
+
+// ListMergeRequestNotesOptions represents the available
+// ListMergeRequestNotes() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#list-all-merge-request-notes
+type ListMergeRequestNotesOptions struct {
+	ListOptions `query:",inline"`
+}
+
+// ListMergeRequestNotes gets a list of all notes of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#list-all-merge-request-notes
+func (s *NotesService) ListMergeRequestNotes(ctx context.Context, pid string, iid int, opts *ListMergeRequestNotesOptions) (*Records[Note], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/notes", pid, iid)
+	var v []*Note
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetMergeRequestNote gets a single note of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#get-single-merge-request-note
+func (s *NotesService) GetMergeRequestNote(ctx context.Context, pid string, iid, note int) (*Note, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/notes/%d", pid, iid, note)
+	var v Note
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateMergeRequestNoteOptions represents the available
+// CreateMergeRequestNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
+type CreateMergeRequestNoteOptions struct {
+	Body                    *string    `json:"body,omitempty"`
+	CreatedAt               *time.Time `json:"created_at,omitempty"`
+	Internal                *bool      `json:"internal,omitempty"`
+	MergeRequestDiffHeadSHA *string    `json:"merge_request_diff_head_sha,omitempty"`
+}
+
+// CreateMergeRequestNote creates a new note on a merge request. To comment
+// on a diff line, use DiscussionsService.CreateMergeRequestDiscussion.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
+func (s *NotesService) CreateMergeRequestNote(ctx context.Context, pid string, iid int, opts *CreateMergeRequestNoteOptions) (*Note, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/notes", pid, iid)
+	var v Note
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateMergeRequestNoteOptions represents the available
+// UpdateMergeRequestNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#modify-existing-merge-request-note
+type UpdateMergeRequestNoteOptions struct {
+	Body *string `json:"body,omitempty"`
+}
+
+// UpdateMergeRequestNote modifies an existing note of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#modify-existing-merge-request-note
+func (s *NotesService) UpdateMergeRequestNote(ctx context.Context, pid string, iid, note int, opts *UpdateMergeRequestNoteOptions) (*Note, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/notes/%d", pid, iid, note)
+	var v Note
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteMergeRequestNote deletes an existing note of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#delete-a-merge-request-note
+func (s *NotesService) DeleteMergeRequestNote(ctx context.Context, pid string, iid, note int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/merge_requests/%d/notes/%d", pid, iid, note)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
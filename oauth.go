@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 )
 
@@ -13,7 +14,13 @@ import (
 type OAuthService struct {
 	client     *Client
 	credential Credential
-	store      store
+
+	// stores keeps token state per credential, keyed by the member index
+	// of a ChainCredential (0 otherwise), so members never share an access
+	// token. Keying on the index rather than the Credential itself keeps
+	// non-comparable credentials from panicking.
+	mu     sync.Mutex
+	stores map[int]*store
 }
 
 type store struct {
@@ -76,19 +83,56 @@ func (oa *OAuthService) GetAccessToken(ctx context.Context, opts ...*GetAccessTo
 	if len(opts) > 0 && opts[0] != nil {
 		opt = opts[0]
 	}
+	idx, credential := oa.current()
+	return oa.getAccessToken(ctx, idx, credential, opt)
+}
+
+// current resolves the credential requests are made with, picking the
+// active member of a ChainCredential, and returns it with its store key.
+func (oa *OAuthService) current() (int, Credential) {
+	if chain, ok := oa.credential.(*ChainCredential); ok {
+		return chain.current()
+	}
+	return 0, oa.credential
+}
 
+// setCredential replaces the credential and drops the token state of the
+// previous one.
+func (oa *OAuthService) setCredential(credential Credential) {
+	oa.mu.Lock()
+	defer oa.mu.Unlock()
+	oa.credential = credential
+	oa.stores = nil
+}
+
+func (oa *OAuthService) storeFor(idx int) *store {
+	oa.mu.Lock()
+	defer oa.mu.Unlock()
+	if oa.stores == nil {
+		oa.stores = make(map[int]*store)
+	}
+	s, ok := oa.stores[idx]
+	if !ok {
+		s = new(store)
+		oa.stores[idx] = s
+	}
+	return s
+}
+
+func (oa *OAuthService) getAccessToken(ctx context.Context, idx int, credential Credential, opt *GetAccessTokenOptions) (*AccessToken, error) {
+	if credential == nil {
+		return nil, ErrCredential
+	}
+
+	s := oa.storeFor(idx)
 	if opt.RefreshToken == "" {
-		storeToken := oa.store.value()
+		storeToken := s.value()
 		if storeToken != nil {
 			return storeToken, nil
 		}
 	}
 
-	if oa.credential == nil {
-		return nil, ErrCredential
-	}
-
-	req := oa.credential.RequestBody(opt)
+	req := credential.RequestBody(opt)
 	if req == nil {
 		return nil, nil
 	}
@@ -97,6 +141,6 @@ func (oa *OAuthService) GetAccessToken(ctx context.Context, opts ...*GetAccessTo
 	if _, err := oa.client.Invoke(ctx, http.MethodPost, "/oauth/token", req, &respBody); err != nil {
 		return nil, err
 	}
-	oa.store.memory(&respBody)
+	s.memory(&respBody)
 	return &respBody, nil
 }
@@ -0,0 +1,218 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PersonalAccessTokensService handles communication with the personal access
+// tokens related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/personal_access_tokens.html
+type PersonalAccessTokensService service
+
+// PersonalAccessToken represents a personal access token.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/personal_access_tokens.html
+type PersonalAccessToken struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Revoked     bool       `json:"revoked"`
+	CreatedAt   *time.Time `json:"created_at"`
+	Scopes      []string   `json:"scopes"`
+	UserID      int        `json:"user_id"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	Active      bool       `json:"active"`
+	ExpiresAt   *Date      `json:"expires_at"`
+	Token       string     `json:"token,omitempty"`
+}
+
+// ListPersonalAccessTokensOptions represents the available
+// ListPersonalAccessTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#list-personal-access-tokens
+type ListPersonalAccessTokensOptions struct {
+	ListOptions `query:",inline"`
+
+	CreatedAfter   *time.Time `query:"created_after,omitempty"`
+	CreatedBefore  *time.Time `query:"created_before,omitempty"`
+	LastUsedAfter  *time.Time `query:"last_used_after,omitempty"`
+	LastUsedBefore *time.Time `query:"last_used_before,omitempty"`
+	Revoked        *bool      `query:"revoked,omitempty"`
+	Search         *string    `query:"search,omitempty"`
+	State          *string    `query:"state,omitempty"`
+	UserID         *int       `query:"user_id,omitempty"`
+}
+
+// ListPersonalAccessTokens gets a list of personal access tokens. Admins see
+// the tokens of all users, everyone else only their own.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#list-personal-access-tokens
+func (s *PersonalAccessTokensService) ListPersonalAccessTokens(ctx context.Context, opts *ListPersonalAccessTokensOptions) (*Records[PersonalAccessToken], error) {
+	var v []*PersonalAccessToken
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, "personal_access_tokens", opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetPersonalAccessToken gets a single personal access token by id.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#get-details-on-a-personal-access-token
+func (s *PersonalAccessTokensService) GetPersonalAccessToken(ctx context.Context, id int) (*PersonalAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("personal_access_tokens/%d", id)
+	var v PersonalAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetSelfPersonalAccessToken gets the token used to authenticate the request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#self-inform
+func (s *PersonalAccessTokensService) GetSelfPersonalAccessToken(ctx context.Context) (*PersonalAccessToken, error) {
+	var v PersonalAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, "personal_access_tokens/self", nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreatePersonalAccessTokenOptions represents the available
+// CreatePersonalAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-personal-access-token
+type CreatePersonalAccessTokenOptions struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	ExpiresAt   *Date     `json:"expires_at,omitempty"`
+	Scopes      *[]string `json:"scopes,omitempty"`
+}
+
+// CreatePersonalAccessToken creates a personal access token for a user.
+// Only available to admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-personal-access-token
+func (s *PersonalAccessTokensService) CreatePersonalAccessToken(ctx context.Context, uid int, opts *CreatePersonalAccessTokenOptions) (*PersonalAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("users/%d/personal_access_tokens", uid)
+	var v PersonalAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RevokePersonalAccessToken revokes a personal access token by id.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#revoke-a-personal-access-token
+func (s *PersonalAccessTokensService) RevokePersonalAccessToken(ctx context.Context, id int) error {
+	apiEndpoint := fmt.Sprintf("personal_access_tokens/%d", id)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RevokeSelfPersonalAccessToken revokes the token used to authenticate the
+// request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#self-revoke
+func (s *PersonalAccessTokensService) RevokeSelfPersonalAccessToken(ctx context.Context) error {
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, "personal_access_tokens/self", nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RotatePersonalAccessTokenOptions represents the available
+// RotatePersonalAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+type RotatePersonalAccessTokenOptions struct {
+	// Defaults to one week from today when omitted.
+	ExpiresAt *Date `json:"expires_at,omitempty"`
+}
+
+// RotatePersonalAccessToken revokes a token and returns a new one that
+// expires at the given date.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+func (s *PersonalAccessTokensService) RotatePersonalAccessToken(ctx context.Context, id int, opts *RotatePersonalAccessTokenOptions) (*PersonalAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("personal_access_tokens/%d/rotate", id)
+	var v PersonalAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RotateSelfPersonalAccessToken rotates the token used to authenticate the
+// request. It works for project and group access tokens as well.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#self-rotate
+func (s *PersonalAccessTokensService) RotateSelfPersonalAccessToken(ctx context.Context, opts *RotatePersonalAccessTokenOptions) (*PersonalAccessToken, error) {
+	var v PersonalAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, "personal_access_tokens/self/rotate", opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RotateCredential rotates the token held by credential and swaps the new
+// token into it atomically, so a long-running client keeps working without
+// being rebuilt. The request is authenticated with credential itself,
+// whichever credential the client was created with.
+//
+// The old token is revoked by GitLab as soon as the call succeeds.
+func (s *PersonalAccessTokensService) RotateCredential(ctx context.Context, credential *TokenCredential, opts *RotatePersonalAccessTokenOptions) (*PersonalAccessToken, error) {
+	var v PersonalAccessToken
+	_, err := s.client.Invoke(ctx, http.MethodPost, s.client.API("personal_access_tokens/self/rotate"), opts, &v,
+		func(request *http.Request) error {
+			return credential.Auth(request, nil)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if v.Token != "" {
+		credential.SetAccessToken(v.Token)
+	}
+	return &v, nil
+}
+
+// RotateCredentialIfExpiring rotates credential with RotateCredential when
+// its token expires within the given window, and reports whether it did.
+func (s *PersonalAccessTokensService) RotateCredentialIfExpiring(ctx context.Context, credential *TokenCredential, within time.Duration, opts *RotatePersonalAccessTokenOptions) (*PersonalAccessToken, bool, error) {
+	var self PersonalAccessToken
+	_, err := s.client.Invoke(ctx, http.MethodGet, s.client.API("personal_access_tokens/self"), nil, &self,
+		func(request *http.Request) error {
+			return credential.Auth(request, nil)
+		})
+	if err != nil {
+		return nil, false, err
+	}
+	if self.ExpiresAt == nil || self.ExpiresAt.IsZero() || time.Until(self.ExpiresAt.t) > within {
+		return &self, false, nil
+	}
+
+	token, err := s.RotateCredential(ctx, credential, opts)
+	if err != nil {
+		return nil, false, err
+	}
+	return token, true, nil
+}
@@ -0,0 +1,124 @@
+package gitlab_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nexuer/go-gitlab"
+)
+
+func TestPersonalAccessTokensService_GetSelfPersonalAccessToken(t *testing.T) {
+	client := gitlab.NewClient(testTokenCredential, &gitlab.Options{Debug: true})
+
+	token, err := client.PersonalAccessTokens.GetSelfPersonalAccessToken(context.Background())
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.GetSelfPersonalAccessToken returned error: %v", err)
+	}
+
+	t.Logf("PersonalAccessTokens.GetSelfPersonalAccessToken returned: %+v", token)
+}
+
+func TestPersonalAccessTokensService_ListPersonalAccessTokens(t *testing.T) {
+	client := gitlab.NewClient(testTokenCredential, &gitlab.Options{Debug: true})
+
+	tokens, err := client.PersonalAccessTokens.ListPersonalAccessTokens(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.ListPersonalAccessTokens returned error: %v", err)
+	}
+
+	t.Logf("PersonalAccessTokens.ListPersonalAccessTokens returned: %+v", tokens)
+}
+
+// rotationServer serves the self endpoints of a personal access token that
+// expires at expiresAt, accepting only the current token.
+func rotationServer(t *testing.T, expiresAt string, rotations *int) *httptest.Server {
+	current := "old"
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != current {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"401 Unauthorized"}`))
+			return
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v4/personal_access_tokens/self":
+			_, _ = fmt.Fprintf(w, `{"id":1,"name":"bot","expires_at":%s}`, expiresAt)
+		case "POST /api/v4/personal_access_tokens/self/rotate":
+			*rotations++
+			current = fmt.Sprintf("new-%d", *rotations)
+			_, _ = fmt.Fprintf(w, `{"id":%d,"name":"bot","token":%q}`, *rotations+1, current)
+		case "GET /api/v4/version":
+			_, _ = w.Write([]byte(`{"version":"17.0.0"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestPersonalAccessTokensService_RotateCredential(t *testing.T) {
+	var rotations int
+	srv := rotationServer(t, "null", &rotations)
+	defer srv.Close()
+
+	// The client's own credential is rejected, so rotating only works when
+	// the request is authenticated with the credential passed in.
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, TokenType: gitlab.PrivateToken, AccessToken: "client"})
+	credential := &gitlab.TokenCredential{Endpoint: srv.URL, TokenType: gitlab.PrivateToken, AccessToken: "old"}
+
+	token, err := client.PersonalAccessTokens.RotateCredential(context.Background(), credential, nil)
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.RotateCredential returned error: %v", err)
+	}
+	if token.Token != "new-1" || credential.Token() != "new-1" {
+		t.Errorf("got token %q, credential holds %q", token.Token, credential.Token())
+	}
+
+	// The old token is revoked; the next call must use the new one.
+	if _, err = gitlab.NewClient(credential).Version.GetVersion(context.Background()); err != nil {
+		t.Fatalf("call after rotation returned error: %v", err)
+	}
+}
+
+func TestPersonalAccessTokensService_RotateCredentialIfExpiring(t *testing.T) {
+	date := func(d time.Duration) string {
+		return `"` + time.Now().Add(d).Format(time.DateOnly) + `"`
+	}
+	tests := []struct {
+		name      string
+		expiresAt string
+		rotated   bool
+	}{
+		{name: "far off", expiresAt: date(30 * 24 * time.Hour), rotated: false},
+		{name: "close", expiresAt: date(24 * time.Hour), rotated: true},
+		{name: "never expires", expiresAt: "null", rotated: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rotations int
+			srv := rotationServer(t, tt.expiresAt, &rotations)
+			defer srv.Close()
+
+			client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, TokenType: gitlab.PrivateToken, AccessToken: "client"})
+			credential := &gitlab.TokenCredential{Endpoint: srv.URL, TokenType: gitlab.PrivateToken, AccessToken: "old"}
+
+			_, rotated, err := client.PersonalAccessTokens.RotateCredentialIfExpiring(context.Background(), credential, 7*24*time.Hour, nil)
+			if err != nil {
+				t.Fatalf("PersonalAccessTokens.RotateCredentialIfExpiring returned error: %v", err)
+			}
+			if rotated != tt.rotated {
+				t.Errorf("got rotated %v, want %v", rotated, tt.rotated)
+			}
+			want, wantRotations := "old", 0
+			if tt.rotated {
+				want, wantRotations = "new-1", 1
+			}
+			if credential.Token() != want || rotations != wantRotations {
+				t.Errorf("credential holds %q after %d rotations, want %q after %d", credential.Token(), rotations, want, wantRotations)
+			}
+		})
+	}
+}
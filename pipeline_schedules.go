@@ -0,0 +1,264 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PipelineSchedulesService handles communication with the pipeline
+// schedules related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/pipeline_schedules.html
+type PipelineSchedulesService service
+
+// PipelineSchedule represents a pipeline schedule.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/pipeline_schedules.html
+type PipelineSchedule struct {
+	ID           int                         `json:"id"`
+	Description  string                      `json:"description"`
+	Ref          string                      `json:"ref"`
+	Cron         string                      `json:"cron"`
+	CronTimezone string                      `json:"cron_timezone"`
+	NextRunAt    *time.Time                  `json:"next_run_at"`
+	Active       bool                        `json:"active"`
+	CreatedAt    *time.Time                  `json:"created_at"`
+	UpdatedAt    *time.Time                  `json:"updated_at"`
+	Owner        *BasicUser                  `json:"owner"`
+	LastPipeline *PipelineInfo               `json:"last_pipeline"`
+	Variables    []*PipelineScheduleVariable `json:"variables"`
+}
+
+// PipelineScheduleVariable represents a pipeline schedule variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#pipeline-schedule-variables
+type PipelineScheduleVariable struct {
+	Key          string            `json:"key"`
+	Value        string            `json:"value"`
+	VariableType VariableTypeValue `json:"variable_type"`
+}
+
+// ListPipelineSchedulesOptions represents the available
+// ListPipelineSchedules() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#get-all-pipeline-schedules
+type ListPipelineSchedulesOptions struct {
+	ListOptions `query:",inline"`
+
+	// Scope is one of active or inactive.
+	Scope *string `query:"scope,omitempty"`
+}
+
+// ListPipelineSchedules gets a list of the pipeline schedules of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#get-all-pipeline-schedules
+func (s *PipelineSchedulesService) ListPipelineSchedules(ctx context.Context, pid string, opts *ListPipelineSchedulesOptions) (*Records[PipelineSchedule], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules", pid)
+	var v []*PipelineSchedule
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetPipelineSchedule gets a single pipeline schedule, with its variables.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#get-a-single-pipeline-schedule
+func (s *PipelineSchedulesService) GetPipelineSchedule(ctx context.Context, pid string, schedule int) (*PipelineSchedule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d", pid, schedule)
+	var v PipelineSchedule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ListPipelinesTriggeredByScheduleOptions represents the available
+// ListPipelinesTriggeredBySchedule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#get-all-pipelines-triggered-by-a-pipeline-schedule
+type ListPipelinesTriggeredByScheduleOptions struct {
+	ListOptions `query:",inline"`
+
+	Scope  *string          `query:"scope,omitempty"`
+	Status *BuildStateValue `query:"status,omitempty"`
+}
+
+// ListPipelinesTriggeredBySchedule gets the pipelines triggered by a
+// pipeline schedule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#get-all-pipelines-triggered-by-a-pipeline-schedule
+func (s *PipelineSchedulesService) ListPipelinesTriggeredBySchedule(ctx context.Context, pid string, schedule int, opts *ListPipelinesTriggeredByScheduleOptions) (*Records[Pipeline], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d/pipelines", pid, schedule)
+	var v []*Pipeline
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// CreatePipelineScheduleOptions represents the available
+// CreatePipelineSchedule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#create-a-new-pipeline-schedule
+type CreatePipelineScheduleOptions struct {
+	Description  *string `json:"description,omitempty"`
+	Ref          *string `json:"ref,omitempty"`
+	Cron         *string `json:"cron,omitempty"`
+	CronTimezone *string `json:"cron_timezone,omitempty"`
+	Active       *bool   `json:"active,omitempty"`
+}
+
+// CreatePipelineSchedule creates a pipeline schedule. The cron expression
+// is checked with ValidateCron before the request is sent.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#create-a-new-pipeline-schedule
+func (s *PipelineSchedulesService) CreatePipelineSchedule(ctx context.Context, pid string, opts *CreatePipelineScheduleOptions) (*PipelineSchedule, error) {
+	if opts != nil && opts.Cron != nil {
+		if err := ValidateCron(*opts.Cron); err != nil {
+			return nil, err
+		}
+	}
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules", pid)
+	var v PipelineSchedule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdatePipelineScheduleOptions represents the available
+// UpdatePipelineSchedule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#edit-a-pipeline-schedule
+type UpdatePipelineScheduleOptions struct {
+	Description  *string `json:"description,omitempty"`
+	Ref          *string `json:"ref,omitempty"`
+	Cron         *string `json:"cron,omitempty"`
+	CronTimezone *string `json:"cron_timezone,omitempty"`
+	Active       *bool   `json:"active,omitempty"`
+}
+
+// UpdatePipelineSchedule updates a pipeline schedule. The cron expression,
+// if set, is checked with ValidateCron before the request is sent.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#edit-a-pipeline-schedule
+func (s *PipelineSchedulesService) UpdatePipelineSchedule(ctx context.Context, pid string, schedule int, opts *UpdatePipelineScheduleOptions) (*PipelineSchedule, error) {
+	if opts != nil && opts.Cron != nil {
+		if err := ValidateCron(*opts.Cron); err != nil {
+			return nil, err
+		}
+	}
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d", pid, schedule)
+	var v PipelineSchedule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// TakeOwnershipOfPipelineSchedule makes the current user the owner of a
+// pipeline schedule, so it runs with their permissions.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#take-ownership-of-a-pipeline-schedule
+func (s *PipelineSchedulesService) TakeOwnershipOfPipelineSchedule(ctx context.Context, pid string, schedule int) (*PipelineSchedule, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d/take_ownership", pid, schedule)
+	var v PipelineSchedule
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeletePipelineSchedule deletes a pipeline schedule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#delete-a-pipeline-schedule
+func (s *PipelineSchedulesService) DeletePipelineSchedule(ctx context.Context, pid string, schedule int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d", pid, schedule)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlayPipelineSchedule runs a pipeline schedule immediately. The pipeline is
+// created asynchronously, so none is returned; the next scheduled run is
+// not affected.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#run-a-scheduled-pipeline-immediately
+func (s *PipelineSchedulesService) PlayPipelineSchedule(ctx context.Context, pid string, schedule int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d/play", pid, schedule)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PipelineScheduleVariableOptions represents the available
+// CreatePipelineScheduleVariable() and UpdatePipelineScheduleVariable()
+// options. Key is ignored on update.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#create-a-new-pipeline-schedule-variable
+type PipelineScheduleVariableOptions struct {
+	Key          *string            `json:"key,omitempty"`
+	Value        *string            `json:"value,omitempty"`
+	VariableType *VariableTypeValue `json:"variable_type,omitempty"`
+}
+
+// CreatePipelineScheduleVariable creates a variable of a pipeline schedule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#create-a-new-pipeline-schedule-variable
+func (s *PipelineSchedulesService) CreatePipelineScheduleVariable(ctx context.Context, pid string, schedule int, opts *PipelineScheduleVariableOptions) (*PipelineScheduleVariable, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables", pid, schedule)
+	var v PipelineScheduleVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdatePipelineScheduleVariable updates a variable of a pipeline schedule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#edit-a-pipeline-schedule-variable
+func (s *PipelineSchedulesService) UpdatePipelineScheduleVariable(ctx context.Context, pid string, schedule int, key string, opts *PipelineScheduleVariableOptions) (*PipelineScheduleVariable, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pid, schedule, key)
+	var v PipelineScheduleVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeletePipelineScheduleVariable deletes a variable of a pipeline schedule
+// and returns it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#delete-a-pipeline-schedule-variable
+func (s *PipelineSchedulesService) DeletePipelineScheduleVariable(ctx context.Context, pid string, schedule int, key string) (*PipelineScheduleVariable, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pid, schedule, key)
+	var v PipelineScheduleVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
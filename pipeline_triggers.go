@@ -0,0 +1,166 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nexuer/utils/ptr"
+)
+
+// PipelineTriggersService handles communication with the pipeline trigger
+// tokens related methods of the GitLab API.
+//
+// TriggerPipeline authenticates with a trigger token or CI_JOB_TOKEN, taken
+// from TriggerPipelineOptions.Token or a TriggerTokenCredential; managing
+// trigger tokens needs a user credential.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/pipeline_triggers.html
+type PipelineTriggersService service
+
+// PipelineTrigger represents a pipeline trigger token.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/pipeline_triggers.html
+type PipelineTrigger struct {
+	ID          int        `json:"id"`
+	Description string     `json:"description"`
+	Token       string     `json:"token"`
+	Owner       *BasicUser `json:"owner"`
+	LastUsed    *time.Time `json:"last_used"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+// ListPipelineTriggersOptions represents the available
+// ListPipelineTriggers() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#list-project-trigger-tokens
+type ListPipelineTriggersOptions struct {
+	ListOptions `query:",inline"`
+}
+
+// ListPipelineTriggers gets a list of the trigger tokens of a project. Only
+// tokens owned by the current user are shown in full.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#list-project-trigger-tokens
+func (s *PipelineTriggersService) ListPipelineTriggers(ctx context.Context, pid string, opts *ListPipelineTriggersOptions) (*Records[PipelineTrigger], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/triggers", pid)
+	var v []*PipelineTrigger
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetPipelineTrigger gets a single trigger token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#get-trigger-token-details
+func (s *PipelineTriggersService) GetPipelineTrigger(ctx context.Context, pid string, trigger int) (*PipelineTrigger, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/triggers/%d", pid, trigger)
+	var v PipelineTrigger
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// PipelineTriggerOptions represents the available CreatePipelineTrigger()
+// and UpdatePipelineTrigger() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#create-a-trigger-token
+type PipelineTriggerOptions struct {
+	Description *string `json:"description,omitempty"`
+}
+
+// CreatePipelineTrigger creates a trigger token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#create-a-trigger-token
+func (s *PipelineTriggersService) CreatePipelineTrigger(ctx context.Context, pid string, opts *PipelineTriggerOptions) (*PipelineTrigger, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/triggers", pid)
+	var v PipelineTrigger
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdatePipelineTrigger updates a trigger token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#update-a-project-trigger-token
+func (s *PipelineTriggersService) UpdatePipelineTrigger(ctx context.Context, pid string, trigger int, opts *PipelineTriggerOptions) (*PipelineTrigger, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/triggers/%d", pid, trigger)
+	var v PipelineTrigger
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeletePipelineTrigger deletes a trigger token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#remove-a-project-trigger-token
+func (s *PipelineTriggersService) DeletePipelineTrigger(ctx context.Context, pid string, trigger int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/triggers/%d", pid, trigger)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TriggerPipelineOptions represents the available TriggerPipeline() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#trigger-a-pipeline-with-a-token
+type TriggerPipelineOptions struct {
+	Ref *string `json:"ref,omitempty"`
+	// Token is a trigger token or CI_JOB_TOKEN. It can be left empty when
+	// the client uses a TriggerTokenCredential.
+	Token     *string           `json:"token,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+	Inputs    map[string]any    `json:"inputs,omitempty"`
+}
+
+// TriggerPipeline creates a pipeline with a trigger token. The token comes
+// from opts.Token or, with a client created from a TriggerTokenCredential,
+// from the credential:
+//
+//	client := gitlab.NewClient(&gitlab.TriggerTokenCredential{
+//		Endpoint: "https://gitlab.example.com",
+//		Token:    "glptt-...",
+//	})
+//	pipeline, err := client.PipelineTriggers.TriggerPipeline(ctx, "1", &gitlab.TriggerPipelineOptions{
+//		Ref: ptr.Ptr("main"),
+//	})
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html#trigger-a-pipeline-with-a-token
+func (s *PipelineTriggersService) TriggerPipeline(ctx context.Context, pid string, opts *TriggerPipelineOptions) (*Pipeline, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/trigger/pipeline", pid)
+	if opts == nil || opts.Token == nil {
+		if _, credential := s.client.OAuth.current(); credential != nil {
+			if trigger, ok := credential.(*TriggerTokenCredential); ok {
+				o := TriggerPipelineOptions{}
+				if opts != nil {
+					o = *opts
+				}
+				o.Token = ptr.Ptr(trigger.Token)
+				opts = &o
+			}
+		}
+	}
+	var v Pipeline
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
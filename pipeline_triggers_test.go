@@ -0,0 +1,45 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestPipelineTriggersService_TriggerPipeline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/1/trigger/pipeline" {
+			http.Error(w, `{"message":"404 Not Found"}`, http.StatusNotFound)
+			return
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("token leaked into the URL: %q", r.URL.RawQuery)
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["token"] != "glptt-1" {
+			t.Errorf("got body token %v, want %q", body["token"], "glptt-1")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 9, "ref": body["ref"], "status": "created"})
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TriggerTokenCredential{Endpoint: srv.URL, Token: "glptt-1"})
+
+	pipeline, err := client.PipelineTriggers.TriggerPipeline(context.Background(), "1", &gitlab.TriggerPipelineOptions{
+		Ref:       ptr.Ptr("main"),
+		Variables: map[string]string{"DEPLOY": "true"},
+	})
+	if err != nil {
+		t.Fatalf("PipelineTriggers.TriggerPipeline returned error: %v", err)
+	}
+	if pipeline.ID != 9 || pipeline.Ref != "main" {
+		t.Errorf("unexpected pipeline: %+v", pipeline)
+	}
+}
@@ -0,0 +1,155 @@
+package gitlab
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultWaitMinInterval = 2 * time.Second
+	defaultWaitMaxInterval = 30 * time.Second
+)
+
+// PipelineJobEvent is sent by WaitForPipeline when a job of the pipeline
+// changes status.
+type PipelineJobEvent struct {
+	Job *Job
+	// PreviousStatus is empty the first time the job is seen.
+	PreviousStatus BuildStateValue
+	Status         BuildStateValue
+}
+
+// WaitForPipelineOptions represents the available WaitForPipeline() options.
+type WaitForPipelineOptions struct {
+	// Events receives a PipelineJobEvent for every job status change. Sends
+	// block until received or ctx is done, and the channel is never closed
+	// by WaitForPipeline. Optional.
+	Events chan<- *PipelineJobEvent
+	// MinInterval is the first poll interval. It doubles on every poll that
+	// sees no change, up to MaxInterval, and is reset on changes.
+	// Default: 2s.
+	MinInterval time.Duration
+	// MaxInterval caps the poll interval. Default: 30s.
+	MaxInterval time.Duration
+	// FailFast cancels the pipeline as soon as a job that is not allowed to
+	// fail has failed, then waits for the cancellation to finish.
+	FailFast bool
+}
+
+// PipelineWaitResult is the outcome of WaitForPipeline.
+type PipelineWaitResult struct {
+	Pipeline *Pipeline
+	Jobs     []*Job
+	// FailedJobs are the failed jobs, including those with AllowFailure set.
+	FailedJobs []*Job
+	// Canceled reports whether the pipeline was canceled by FailFast.
+	Canceled bool
+}
+
+// Succeeded reports whether the pipeline finished with success.
+func (r *PipelineWaitResult) Succeeded() bool {
+	return r.Pipeline != nil && BuildStateValue(r.Pipeline.Status) == Success
+}
+
+// WaitForPipeline polls a pipeline until it reaches a finished state (see
+// BuildStateValue.IsFinished) and returns the last pipeline and job states.
+// A pipeline blocked on a manual job is not finished, so callers usually
+// bound the wait with a ctx deadline. On ctx errors the result of the last
+// poll is returned along with the error.
+func (s *PipelinesService) WaitForPipeline(ctx context.Context, pid string, pipeline int, opts *WaitForPipelineOptions) (*PipelineWaitResult, error) {
+	if opts == nil {
+		opts = &WaitForPipelineOptions{}
+	}
+	minInterval, maxInterval := opts.MinInterval, opts.MaxInterval
+	if minInterval <= 0 {
+		minInterval = defaultWaitMinInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = defaultWaitMaxInterval
+	}
+	if maxInterval < minInterval {
+		maxInterval = minInterval
+	}
+
+	var (
+		result   = &PipelineWaitResult{}
+		seen     = make(map[int]BuildStateValue)
+		interval = minInterval
+	)
+	for {
+		p, err := s.GetPipeline(ctx, pid, pipeline)
+		if err != nil {
+			return result, err
+		}
+		jobs, err := s.listAllPipelineJobs(ctx, pid, pipeline)
+		if err != nil {
+			return result, err
+		}
+		result.Pipeline, result.Jobs, result.FailedJobs = p, jobs, nil
+
+		changed, failFast := false, false
+		for _, job := range jobs {
+			prev, ok := seen[job.ID]
+			if !ok || prev != job.Status {
+				changed = true
+				seen[job.ID] = job.Status
+				if opts.Events != nil {
+					select {
+					case opts.Events <- &PipelineJobEvent{Job: job, PreviousStatus: prev, Status: job.Status}:
+					case <-ctx.Done():
+						return result, ctx.Err()
+					}
+				}
+			}
+			if job.Status == Failed {
+				result.FailedJobs = append(result.FailedJobs, job)
+				if !job.AllowFailure {
+					failFast = opts.FailFast
+				}
+			}
+		}
+
+		if BuildStateValue(p.Status).IsFinished() {
+			return result, nil
+		}
+
+		if failFast && !result.Canceled {
+			if p, err = s.CancelPipeline(ctx, pid, pipeline); err != nil {
+				return result, err
+			}
+			result.Pipeline, result.Canceled = p, true
+			changed = true
+		}
+
+		if changed {
+			interval = minInterval
+		} else if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// listAllPipelineJobs gets every job of a pipeline, following pagination.
+func (s *PipelinesService) listAllPipelineJobs(ctx context.Context, pid string, pipeline int) ([]*Job, error) {
+	opts := &ListPipelineJobsOptions{ListOptions: NewListOptions(1, MaxPerPage)}
+	var jobs []*Job
+	for {
+		records, err := s.client.Jobs.ListPipelineJobs(ctx, pid, pipeline, opts)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, records.Records...)
+		if records.NextPage == 0 {
+			return jobs, nil
+		}
+		opts.Page = records.NextPage
+	}
+}
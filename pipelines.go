@@ -1,6 +1,17 @@
 package gitlab
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PipelinesService handles communication with the pipeline related methods
+// of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/pipelines.html
+type PipelinesService service
 
 // Pipeline represents a GitLab pipeline.
 //
@@ -58,3 +69,191 @@ type PipelineInfo struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+// PipelineVariable represents a pipeline variable.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/pipelines.html
+type PipelineVariable struct {
+	Key          string            `json:"key"`
+	Value        string            `json:"value"`
+	VariableType VariableTypeValue `json:"variable_type"`
+}
+
+// PipelineTestReportSummary contains a summary of the test results of a
+// pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-a-test-report-summary-for-a-pipeline
+type PipelineTestReportSummary struct {
+	Total struct {
+		Time       float64 `json:"time"`
+		Count      int     `json:"count"`
+		Success    int     `json:"success"`
+		Failed     int     `json:"failed"`
+		Skipped    int     `json:"skipped"`
+		Error      int     `json:"error"`
+		SuiteError string  `json:"suite_error"`
+	} `json:"total"`
+	TestSuites []*PipelineTestSuiteSummary `json:"test_suites"`
+}
+
+// PipelineTestSuiteSummary contains a summary of a test suite of a pipeline.
+type PipelineTestSuiteSummary struct {
+	Name         string  `json:"name"`
+	TotalTime    float64 `json:"total_time"`
+	TotalCount   int     `json:"total_count"`
+	SuccessCount int     `json:"success_count"`
+	FailedCount  int     `json:"failed_count"`
+	SkippedCount int     `json:"skipped_count"`
+	ErrorCount   int     `json:"error_count"`
+	BuildIDs     []int   `json:"build_ids"`
+	SuiteError   string  `json:"suite_error"`
+}
+
+// ListProjectPipelinesOptions represents the available ListProjectPipelines()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#list-project-pipelines
+type ListProjectPipelinesOptions struct {
+	ListOptions `query:",inline"`
+
+	Scope         *string          `query:"scope,omitempty"`
+	Status        *BuildStateValue `query:"status,omitempty"`
+	Source        *string          `query:"source,omitempty"`
+	Ref           *string          `query:"ref,omitempty"`
+	SHA           *string          `query:"sha,omitempty"`
+	YamlErrors    *bool            `query:"yaml_errors,omitempty"`
+	Name          *string          `query:"name,omitempty"`
+	Username      *string          `query:"username,omitempty"`
+	UpdatedAfter  *time.Time       `query:"updated_after,omitempty"`
+	UpdatedBefore *time.Time       `query:"updated_before,omitempty"`
+	CreatedAfter  *time.Time       `query:"created_after,omitempty"`
+	CreatedBefore *time.Time       `query:"created_before,omitempty"`
+}
+
+// ListProjectPipelines gets a list of project pipelines. The list endpoint
+// only fills the fields also found in PipelineInfo.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#list-project-pipelines
+func (s *PipelinesService) ListProjectPipelines(ctx context.Context, pid string, opts *ListProjectPipelinesOptions) (*Records[Pipeline], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines", pid)
+	var v []*Pipeline
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetPipeline gets a single project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-a-single-pipeline
+func (s *PipelinesService) GetPipeline(ctx context.Context, pid string, pipeline int) (*Pipeline, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines/%d", pid, pipeline)
+	var v Pipeline
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetPipelineVariables gets the variables of a single project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-variables-of-a-pipeline
+func (s *PipelinesService) GetPipelineVariables(ctx context.Context, pid string, pipeline int) ([]*PipelineVariable, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines/%d/variables", pid, pipeline)
+	var v []*PipelineVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetPipelineTestReportSummary gets the test report summary of a single
+// project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-a-test-report-summary-for-a-pipeline
+func (s *PipelinesService) GetPipelineTestReportSummary(ctx context.Context, pid string, pipeline int) (*PipelineTestReportSummary, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines/%d/test_report_summary", pid, pipeline)
+	var v PipelineTestReportSummary
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// PipelineVariableOptions represents a pipeline variable option.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#create-a-new-pipeline
+type PipelineVariableOptions struct {
+	Key          *string            `json:"key,omitempty"`
+	Value        *string            `json:"value,omitempty"`
+	VariableType *VariableTypeValue `json:"variable_type,omitempty"`
+}
+
+// CreatePipelineOptions represents the available CreatePipeline() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#create-a-new-pipeline
+type CreatePipelineOptions struct {
+	Ref       *string                     `json:"ref,omitempty"`
+	Variables *[]*PipelineVariableOptions `json:"variables,omitempty"`
+	Inputs    map[string]any              `json:"inputs,omitempty"`
+}
+
+// CreatePipeline creates a new project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#create-a-new-pipeline
+func (s *PipelinesService) CreatePipeline(ctx context.Context, pid string, opts *CreatePipelineOptions) (*Pipeline, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipeline", pid)
+	var v Pipeline
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RetryPipeline retries the failed or canceled jobs of a pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#retry-jobs-in-a-pipeline
+func (s *PipelinesService) RetryPipeline(ctx context.Context, pid string, pipeline int) (*Pipeline, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines/%d/retry", pid, pipeline)
+	var v Pipeline
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CancelPipeline cancels the running jobs of a pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#cancel-a-pipelines-jobs
+func (s *PipelinesService) CancelPipeline(ctx context.Context, pid string, pipeline int) (*Pipeline, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines/%d/cancel", pid, pipeline)
+	var v Pipeline
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeletePipeline deletes an existing pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#delete-a-pipeline
+func (s *PipelinesService) DeletePipeline(ctx context.Context, pid string, pipeline int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/pipelines/%d", pid, pipeline)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package gitlab_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestPipelinesService_ListProjectPipelines(t *testing.T) {
+	client := gitlab.NewClient(testTokenCredential, &gitlab.Options{Debug: true})
+
+	pipelines, err := client.Pipelines.ListProjectPipelines(context.Background(), "971", &gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.NewListOptions(1, 5),
+		Status:      ptr.Ptr(gitlab.Success),
+	})
+	if err != nil {
+		t.Fatalf("Pipelines.ListProjectPipelines returned error: %v", err)
+	}
+	for _, pipeline := range pipelines.Records {
+		t.Logf("pipeline: %d %s %s\n", pipeline.ID, pipeline.Ref, pipeline.Status)
+	}
+}
+
+func TestPipelinesService_WaitForPipeline(t *testing.T) {
+	polls := 0
+	canceled := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/projects/1/pipelines/5":
+			polls++
+			status := gitlab.Running
+			if canceled {
+				status = gitlab.Canceled
+			}
+			_, _ = fmt.Fprintf(w, `{"id":5,"status":%q}`, status)
+		case "/api/v4/projects/1/pipelines/5/cancel":
+			canceled = true
+			_, _ = fmt.Fprint(w, `{"id":5,"status":"canceling"}`)
+		case "/api/v4/projects/1/pipelines/5/jobs":
+			test := gitlab.Running
+			if polls > 1 {
+				test = gitlab.Failed
+			}
+			_, _ = fmt.Fprintf(w, `[{"id":1,"name":"lint","status":"failed","allow_failure":true},{"id":2,"name":"test","status":%q}]`, test)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	events := make(chan *gitlab.PipelineJobEvent, 10)
+	result, err := client.Pipelines.WaitForPipeline(context.Background(), "1", 5, &gitlab.WaitForPipelineOptions{
+		Events:      events,
+		MinInterval: time.Millisecond,
+		FailFast:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(events)
+
+	if !result.Canceled || result.Succeeded() || gitlab.BuildStateValue(result.Pipeline.Status) != gitlab.Canceled {
+		t.Errorf("unexpected result: canceled=%v status=%s", result.Canceled, result.Pipeline.Status)
+	}
+	if len(result.FailedJobs) != 2 {
+		t.Errorf("got %d failed jobs, want 2", len(result.FailedJobs))
+	}
+
+	var got []string
+	for e := range events {
+		got = append(got, fmt.Sprintf("%s:%s->%s", e.Job.Name, e.PreviousStatus, e.Status))
+	}
+	want := []string{"lint:->failed", "test:->running", "test:running->failed"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+}
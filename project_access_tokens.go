@@ -0,0 +1,132 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ProjectAccessTokensService handles communication with the project access
+// tokens related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_access_tokens.html
+type ProjectAccessTokensService service
+
+// ProjectAccessToken represents a GitLab project access token.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_access_tokens.html
+type ProjectAccessToken struct {
+	ID          int              `json:"id"`
+	UserID      int              `json:"user_id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Scopes      []string         `json:"scopes"`
+	CreatedAt   *time.Time       `json:"created_at"`
+	LastUsedAt  *time.Time       `json:"last_used_at"`
+	ExpiresAt   *Date            `json:"expires_at"`
+	Active      bool             `json:"active"`
+	Revoked     bool             `json:"revoked"`
+	Token       string           `json:"token,omitempty"`
+	AccessLevel AccessLevelValue `json:"access_level"`
+}
+
+// ListProjectAccessTokensOptions represents the available
+// ListProjectAccessTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#list-project-access-tokens
+type ListProjectAccessTokensOptions struct {
+	ListOptions `query:",inline"`
+
+	State *string `query:"state,omitempty"`
+}
+
+// ListProjectAccessTokens gets a list of all project access tokens in a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#list-project-access-tokens
+func (s *ProjectAccessTokensService) ListProjectAccessTokens(ctx context.Context, pid string, opts *ListProjectAccessTokensOptions) (*Records[ProjectAccessToken], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/access_tokens", pid)
+	var v []*ProjectAccessToken
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetProjectAccessToken gets a single project access token in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#get-a-project-access-token
+func (s *ProjectAccessTokensService) GetProjectAccessToken(ctx context.Context, pid string, id int) (*ProjectAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/access_tokens/%d", pid, id)
+	var v ProjectAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateProjectAccessTokenOptions represents the available
+// CreateProjectAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#create-a-project-access-token
+type CreateProjectAccessTokenOptions struct {
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Scopes      *[]string         `json:"scopes,omitempty"`
+	AccessLevel *AccessLevelValue `json:"access_level,omitempty"`
+	ExpiresAt   *Date             `json:"expires_at,omitempty"`
+}
+
+// CreateProjectAccessToken creates a new project access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#create-a-project-access-token
+func (s *ProjectAccessTokensService) CreateProjectAccessToken(ctx context.Context, pid string, opts *CreateProjectAccessTokenOptions) (*ProjectAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/access_tokens", pid)
+	var v ProjectAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RotateProjectAccessTokenOptions represents the available
+// RotateProjectAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#rotate-a-project-access-token
+type RotateProjectAccessTokenOptions struct {
+	ExpiresAt *Date `json:"expires_at,omitempty"`
+}
+
+// RotateProjectAccessToken revokes a project access token and returns a new
+// one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#rotate-a-project-access-token
+func (s *ProjectAccessTokensService) RotateProjectAccessToken(ctx context.Context, pid string, id int, opts *RotateProjectAccessTokenOptions) (*ProjectAccessToken, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/access_tokens/%d/rotate", pid, id)
+	var v ProjectAccessToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RevokeProjectAccessToken revokes a project access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#revoke-a-project-access-token
+func (s *ProjectAccessTokensService) RevokeProjectAccessToken(ctx context.Context, pid string, id int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/access_tokens/%d", pid, id)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nexuer/ghttp"
+)
+
+// ProjectVariablesService handles communication with the project CI/CD
+// variables related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_level_variables.html
+type ProjectVariablesService service
+
+// ProjectVariable represents a project CI/CD variable. The Value of a hidden
+// variable is never returned by the API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_level_variables.html
+type ProjectVariable struct {
+	Key              string            `json:"key"`
+	Value            string            `json:"value"`
+	VariableType     VariableTypeValue `json:"variable_type"`
+	Protected        bool              `json:"protected"`
+	Masked           bool              `json:"masked"`
+	Hidden           bool              `json:"hidden"`
+	Raw              bool              `json:"raw"`
+	EnvironmentScope string            `json:"environment_scope"`
+	Description      string            `json:"description"`
+}
+
+// VariableFilter selects one variable when several share a key with
+// different environment scopes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#the-filter-parameter
+type VariableFilter struct {
+	EnvironmentScope *string
+}
+
+// hook sends the filter as filter[environment_scope] in the query string,
+// which GitLab reads for GET, PUT and DELETE alike.
+func (f *VariableFilter) hook() ghttp.RequestFunc {
+	return func(request *http.Request) error {
+		if f == nil || f.EnvironmentScope == nil {
+			return nil
+		}
+		q := request.URL.Query()
+		q.Set("filter[environment_scope]", *f.EnvironmentScope)
+		request.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// ListProjectVariablesOptions represents the available
+// ListProjectVariables() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#list-project-variables
+type ListProjectVariablesOptions struct {
+	ListOptions `query:",inline"`
+}
+
+// ListProjectVariables gets a list of the CI/CD variables of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#list-project-variables
+func (s *ProjectVariablesService) ListProjectVariables(ctx context.Context, pid string, opts *ListProjectVariablesOptions) (*Records[ProjectVariable], error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/variables", pid)
+	var v []*ProjectVariable
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetProjectVariableOptions represents the available GetProjectVariable()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#get-a-single-variable
+type GetProjectVariableOptions struct {
+	Filter *VariableFilter
+}
+
+// GetProjectVariable gets a single CI/CD variable of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#get-a-single-variable
+func (s *ProjectVariablesService) GetProjectVariable(ctx context.Context, pid, key string, opts *GetProjectVariableOptions) (*ProjectVariable, error) {
+	var filter *VariableFilter
+	if opts != nil {
+		filter = opts.Filter
+	}
+	apiEndpoint := fmt.Sprintf("projects/%s/variables/%s", pid, key)
+	var v ProjectVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v, filter.hook()); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CreateProjectVariableOptions represents the available
+// CreateProjectVariable() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#create-a-variable
+type CreateProjectVariableOptions struct {
+	Key              *string            `json:"key,omitempty"`
+	Value            *string            `json:"value,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	EnvironmentScope *string            `json:"environment_scope,omitempty"`
+	Masked           *bool              `json:"masked,omitempty"`
+	MaskedAndHidden  *bool              `json:"masked_and_hidden,omitempty"`
+	Protected        *bool              `json:"protected,omitempty"`
+	Raw              *bool              `json:"raw,omitempty"`
+	VariableType     *VariableTypeValue `json:"variable_type,omitempty"`
+}
+
+// CreateProjectVariable creates a CI/CD variable of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#create-a-variable
+func (s *ProjectVariablesService) CreateProjectVariable(ctx context.Context, pid string, opts *CreateProjectVariableOptions) (*ProjectVariable, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/variables", pid)
+	var v ProjectVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateProjectVariableOptions represents the available
+// UpdateProjectVariable() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#update-a-variable
+type UpdateProjectVariableOptions struct {
+	Value            *string            `json:"value,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	EnvironmentScope *string            `json:"environment_scope,omitempty"`
+	Masked           *bool              `json:"masked,omitempty"`
+	Protected        *bool              `json:"protected,omitempty"`
+	Raw              *bool              `json:"raw,omitempty"`
+	VariableType     *VariableTypeValue `json:"variable_type,omitempty"`
+	Filter           *VariableFilter    `json:"-"`
+}
+
+// UpdateProjectVariable updates a CI/CD variable of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#update-a-variable
+func (s *ProjectVariablesService) UpdateProjectVariable(ctx context.Context, pid, key string, opts *UpdateProjectVariableOptions) (*ProjectVariable, error) {
+	var filter *VariableFilter
+	if opts != nil {
+		filter = opts.Filter
+	}
+	apiEndpoint := fmt.Sprintf("projects/%s/variables/%s", pid, key)
+	var v ProjectVariable
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v, filter.hook()); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DeleteProjectVariableOptions represents the available
+// DeleteProjectVariable() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#delete-a-variable
+type DeleteProjectVariableOptions struct {
+	Filter *VariableFilter
+}
+
+// DeleteProjectVariable deletes a CI/CD variable of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#delete-a-variable
+func (s *ProjectVariablesService) DeleteProjectVariable(ctx context.Context, pid, key string, opts *DeleteProjectVariableOptions) error {
+	var filter *VariableFilter
+	if opts != nil {
+		filter = opts.Filter
+	}
+	apiEndpoint := fmt.Sprintf("projects/%s/variables/%s", pid, key)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil, filter.hook()); err != nil {
+		return err
+	}
+	return nil
+}
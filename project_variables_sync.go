@@ -0,0 +1,174 @@
+package gitlab
+
+import (
+	"context"
+	"sort"
+
+	"github.com/nexuer/utils/ptr"
+)
+
+// SyncVariablesOptions represents the available SyncVariables() options.
+type SyncVariablesOptions struct {
+	// Prune deletes variables that are not in the desired set.
+	Prune bool
+	// DryRun computes the changes without applying them.
+	DryRun bool
+}
+
+// SyncVariablesResult lists the changes made, or that would be made with
+// DryRun, by SyncVariables.
+type SyncVariablesResult struct {
+	Created   []*ProjectVariable
+	Updated   []*ProjectVariable
+	Deleted   []*ProjectVariable
+	Unchanged []*ProjectVariable
+}
+
+// variableID identifies a variable: keys are unique per environment scope.
+type variableID struct {
+	key, scope string
+}
+
+func projectVariableID(v *ProjectVariable) variableID {
+	scope := v.EnvironmentScope
+	if scope == "" {
+		scope = "*"
+	}
+	return variableID{key: v.Key, scope: scope}
+}
+
+// SyncVariables makes the CI/CD variables of a project match desired and
+// only sends requests for the variables that differ. Variables are matched
+// by key and environment scope, where an empty scope means "*", and are
+// applied in key order: creates and updates first, then deletes.
+//
+// The value of a hidden variable cannot be read back, so hidden variables
+// in desired are always updated. A hidden variable cannot be made visible
+// again; to change that, delete it first.
+//
+// On error, the result lists the changes applied so far.
+func (s *ProjectVariablesService) SyncVariables(ctx context.Context, pid string, desired []*ProjectVariable, opts *SyncVariablesOptions) (*SyncVariablesResult, error) {
+	if opts == nil {
+		opts = &SyncVariablesOptions{}
+	}
+
+	current := make(map[variableID]*ProjectVariable)
+	list := &ListProjectVariablesOptions{ListOptions: NewListOptions(1, MaxPerPage)}
+	for {
+		records, err := s.ListProjectVariables(ctx, pid, list)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range records.Records {
+			current[projectVariableID(v)] = v
+		}
+		if records.NextPage == 0 {
+			break
+		}
+		list.Page = records.NextPage
+	}
+
+	var creates, updates, deletes []*ProjectVariable
+	wanted := make(map[variableID]bool, len(desired))
+	result := &SyncVariablesResult{}
+	for _, want := range desired {
+		id := projectVariableID(want)
+		wanted[id] = true
+		have, ok := current[id]
+		switch {
+		case !ok:
+			creates = append(creates, want)
+		case have.Hidden || !variableEqual(have, want):
+			updates = append(updates, want)
+		default:
+			result.Unchanged = append(result.Unchanged, have)
+		}
+	}
+	if opts.Prune {
+		for id, have := range current {
+			if !wanted[id] {
+				deletes = append(deletes, have)
+			}
+		}
+	}
+	for _, vs := range [][]*ProjectVariable{creates, updates, deletes, result.Unchanged} {
+		sortVariables(vs)
+	}
+
+	if opts.DryRun {
+		result.Created, result.Updated, result.Deleted = creates, updates, deletes
+		return result, nil
+	}
+
+	for _, want := range creates {
+		v, err := s.CreateProjectVariable(ctx, pid, &CreateProjectVariableOptions{
+			Key:              ptr.Ptr(want.Key),
+			Value:            ptr.Ptr(want.Value),
+			Description:      ptr.Ptr(want.Description),
+			EnvironmentScope: ptr.Ptr(projectVariableID(want).scope),
+			Masked:           ptr.Ptr(want.Masked || want.Hidden),
+			MaskedAndHidden:  ptr.Ptr(want.Hidden),
+			Protected:        ptr.Ptr(want.Protected),
+			Raw:              ptr.Ptr(want.Raw),
+			VariableType:     ptr.Ptr(variableType(want.VariableType)),
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Created = append(result.Created, v)
+	}
+	for _, want := range updates {
+		scope := projectVariableID(want).scope
+		v, err := s.UpdateProjectVariable(ctx, pid, want.Key, &UpdateProjectVariableOptions{
+			Value:        ptr.Ptr(want.Value),
+			Description:  ptr.Ptr(want.Description),
+			Masked:       ptr.Ptr(want.Masked || want.Hidden),
+			Protected:    ptr.Ptr(want.Protected),
+			Raw:          ptr.Ptr(want.Raw),
+			VariableType: ptr.Ptr(variableType(want.VariableType)),
+			Filter:       &VariableFilter{EnvironmentScope: ptr.Ptr(scope)},
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Updated = append(result.Updated, v)
+	}
+	for _, have := range deletes {
+		err := s.DeleteProjectVariable(ctx, pid, have.Key, &DeleteProjectVariableOptions{
+			Filter: &VariableFilter{EnvironmentScope: ptr.Ptr(projectVariableID(have).scope)},
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Deleted = append(result.Deleted, have)
+	}
+	return result, nil
+}
+
+func variableType(t VariableTypeValue) VariableTypeValue {
+	if t == "" {
+		return EnvVariableType
+	}
+	return t
+}
+
+// variableEqual compares the settable attributes of two variables with the
+// same key and scope.
+func variableEqual(have, want *ProjectVariable) bool {
+	return have.Value == want.Value &&
+		variableType(have.VariableType) == variableType(want.VariableType) &&
+		have.Protected == want.Protected &&
+		have.Masked == want.Masked &&
+		have.Raw == want.Raw &&
+		have.Description == want.Description
+}
+
+func sortVariables(vs []*ProjectVariable) {
+	sort.Slice(vs, func(i, j int) bool {
+		a, b := projectVariableID(vs[i]), projectVariableID(vs[j])
+		if a.key != b.key {
+			return a.key < b.key
+		}
+		return a.scope < b.scope
+	})
+}
@@ -0,0 +1,65 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+)
+
+func TestProjectVariablesService_SyncVariables(t *testing.T) {
+	current := []*gitlab.ProjectVariable{
+		{Key: "API_URL", Value: "https://old.example.com", EnvironmentScope: "*"},
+		{Key: "API_URL", Value: "https://prod.example.com", EnvironmentScope: "production"},
+		{Key: "LEGACY", Value: "1", EnvironmentScope: "*"},
+	}
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(current)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, "/api/v4/projects/1/variables")
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(key, "/")+" "+r.URL.Query().Get("filter[environment_scope]"))
+		var v gitlab.ProjectVariable
+		_ = json.NewDecoder(r.Body).Decode(&v)
+		_ = json.NewEncoder(w).Encode(v)
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(&gitlab.TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+	desired := []*gitlab.ProjectVariable{
+		{Key: "API_URL", Value: "https://new.example.com"},
+		{Key: "API_URL", Value: "https://prod.example.com", EnvironmentScope: "production"},
+		{Key: "TOKEN", Value: "secret", Masked: true},
+	}
+
+	result, err := client.ProjectVariables.SyncVariables(context.Background(), "1", desired, &gitlab.SyncVariablesOptions{
+		Prune:  true,
+		DryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("ProjectVariables.SyncVariables returned error: %v", err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("dry run sent requests: %v", requests)
+	}
+	if len(result.Created) != 1 || len(result.Updated) != 1 || len(result.Deleted) != 1 || len(result.Unchanged) != 1 {
+		t.Errorf("unexpected dry run result: %+v", result)
+	}
+
+	if _, err = client.ProjectVariables.SyncVariables(context.Background(), "1", desired, &gitlab.SyncVariablesOptions{
+		Prune: true,
+	}); err != nil {
+		t.Fatalf("ProjectVariables.SyncVariables returned error: %v", err)
+	}
+	want := []string{"POST  ", "PUT API_URL *", "DELETE LEGACY *"}
+	if strings.Join(requests, ",") != strings.Join(want, ",") {
+		t.Errorf("got requests %q, want %q", requests, want)
+	}
+}
@@ -8,7 +8,8 @@ import (
 )
 
 // ReleasesService handles communication with the releases methods
-// of the GitLab API.
+// of the GitLab API. It accepts a CI_JOB_TOKEN (TokenCredential with
+// JobToken) in addition to the usual credentials.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/releases/index.html
 type ReleasesService service
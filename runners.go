@@ -0,0 +1,336 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nexuer/utils/ptr"
+)
+
+// RunnersService handles communication with the runner related methods of
+// the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/runners.html
+type RunnersService service
+
+// Runner represents a GitLab CI runner. The fields after Status are only
+// set by GetRunner and UpdateRunner.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/runners.html
+type Runner struct {
+	ID          int               `json:"id"`
+	Description string            `json:"description"`
+	IPAddress   string            `json:"ip_address"`
+	Paused      bool              `json:"paused"`
+	IsShared    bool              `json:"is_shared"`
+	RunnerType  RunnerTypeValue   `json:"runner_type"`
+	Name        string            `json:"name"`
+	Online      bool              `json:"online"`
+	Status      RunnerStatusValue `json:"status"`
+
+	TagList         []string         `json:"tag_list"`
+	RunUntagged     bool             `json:"run_untagged"`
+	Locked          bool             `json:"locked"`
+	MaximumTimeout  int              `json:"maximum_timeout"`
+	AccessLevel     string           `json:"access_level"`
+	Version         string           `json:"version"`
+	Revision        string           `json:"revision"`
+	Platform        string           `json:"platform"`
+	Architecture    string           `json:"architecture"`
+	ContactedAt     *time.Time       `json:"contacted_at"`
+	MaintenanceNote string           `json:"maintenance_note"`
+	Projects        []*RunnerProject `json:"projects"`
+	Groups          []*RunnerGroup   `json:"groups"`
+}
+
+// RunnerProject represents a project a runner is assigned to.
+type RunnerProject struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	NameWithNamespace string `json:"name_with_namespace"`
+	Path              string `json:"path"`
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+// RunnerGroup represents a group a runner is assigned to.
+type RunnerGroup struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	WebURL string `json:"web_url"`
+}
+
+// ListRunnersOptions represents the available ListRunners(),
+// ListAllRunners(), ListProjectRunners() and ListGroupRunners() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#list-owned-runners
+type ListRunnersOptions struct {
+	ListOptions `query:",inline"`
+
+	Type          *RunnerTypeValue   `query:"type,omitempty"`
+	Status        *RunnerStatusValue `query:"status,omitempty"`
+	Paused        *bool              `query:"paused,omitempty"`
+	TagList       *[]string          `query:"tag_list,comma,omitempty"`
+	VersionPrefix *string            `query:"version_prefix,omitempty"`
+}
+
+// ListRunners gets a list of the runners available to the user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#list-owned-runners
+func (s *RunnersService) ListRunners(ctx context.Context, opts *ListRunnersOptions) (*Records[Runner], error) {
+	return s.listRunners(ctx, "runners", opts)
+}
+
+// ListAllRunners gets a list of all runners of the instance. Only available
+// to admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#list-all-runners
+func (s *RunnersService) ListAllRunners(ctx context.Context, opts *ListRunnersOptions) (*Records[Runner], error) {
+	return s.listRunners(ctx, "runners/all", opts)
+}
+
+// ListProjectRunners gets a list of the runners available to a project,
+// including instance and group runners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#list-projects-runners
+func (s *RunnersService) ListProjectRunners(ctx context.Context, pid string, opts *ListRunnersOptions) (*Records[Runner], error) {
+	return s.listRunners(ctx, fmt.Sprintf("projects/%s/runners", pid), opts)
+}
+
+// ListGroupRunners gets a list of the runners available to a group and its
+// subgroups and projects.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#list-groups-runners
+func (s *RunnersService) ListGroupRunners(ctx context.Context, gid string, opts *ListRunnersOptions) (*Records[Runner], error) {
+	return s.listRunners(ctx, fmt.Sprintf("groups/%s/runners", gid), opts)
+}
+
+func (s *RunnersService) listRunners(ctx context.Context, apiEndpoint string, opts *ListRunnersOptions) (*Records[Runner], error) {
+	var v []*Runner
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// GetRunner gets the details of a runner.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#get-runners-details
+func (s *RunnersService) GetRunner(ctx context.Context, rid int) (*Runner, error) {
+	apiEndpoint := fmt.Sprintf("runners/%d", rid)
+	var v Runner
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// UpdateRunnerOptions represents the available UpdateRunner() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#update-runners-details
+type UpdateRunnerOptions struct {
+	Description     *string   `json:"description,omitempty"`
+	Paused          *bool     `json:"paused,omitempty"`
+	TagList         *[]string `json:"tag_list,omitempty"`
+	RunUntagged     *bool     `json:"run_untagged,omitempty"`
+	Locked          *bool     `json:"locked,omitempty"`
+	AccessLevel     *string   `json:"access_level,omitempty"`
+	MaximumTimeout  *int      `json:"maximum_timeout,omitempty"`
+	MaintenanceNote *string   `json:"maintenance_note,omitempty"`
+}
+
+// UpdateRunner updates the details of a runner.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#update-runners-details
+func (s *RunnersService) UpdateRunner(ctx context.Context, rid int, opts *UpdateRunnerOptions) (*Runner, error) {
+	apiEndpoint := fmt.Sprintf("runners/%d", rid)
+	var v Runner
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPut, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// PauseRunner stops a runner from picking up new jobs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#pause-a-runner
+func (s *RunnersService) PauseRunner(ctx context.Context, rid int) (*Runner, error) {
+	return s.UpdateRunner(ctx, rid, &UpdateRunnerOptions{Paused: ptr.Ptr(true)})
+}
+
+// ResumeRunner lets a paused runner pick up jobs again.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#pause-a-runner
+func (s *RunnersService) ResumeRunner(ctx context.Context, rid int) (*Runner, error) {
+	return s.UpdateRunner(ctx, rid, &UpdateRunnerOptions{Paused: ptr.Ptr(false)})
+}
+
+// DeleteRunner deletes a runner by id.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#delete-a-runner-by-id
+func (s *RunnersService) DeleteRunner(ctx context.Context, rid int) error {
+	apiEndpoint := fmt.Sprintf("runners/%d", rid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListRunnerJobsOptions represents the available ListRunnerJobs() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#list-jobs-processed-by-a-runner
+type ListRunnerJobsOptions struct {
+	ListOptions `query:",inline"`
+
+	Status   *BuildStateValue `query:"status,omitempty"`
+	SystemID *string          `query:"system_id,omitempty"`
+}
+
+// ListRunnerJobs gets a list of the jobs processed by a runner, newest
+// first by default.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#list-jobs-processed-by-a-runner
+func (s *RunnersService) ListRunnerJobs(ctx context.Context, rid int, opts *ListRunnerJobsOptions) (*Records[Job], error) {
+	apiEndpoint := fmt.Sprintf("runners/%d/jobs", rid)
+	var v []*Job
+	resp, err := s.client.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, opts, &v)
+	if err != nil {
+		return nil, err
+	}
+	return newRecords(opts, v, resp), nil
+}
+
+// EnableProjectRunner assigns a project or group runner to a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#assign-a-runner-to-project
+func (s *RunnersService) EnableProjectRunner(ctx context.Context, pid string, rid int) (*Runner, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/runners", pid)
+	var v Runner
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, map[string]int{"runner_id": rid}, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DisableProjectRunner unassigns a runner from a project. The project that
+// owns the runner cannot be unassigned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#unassign-a-runner-from-project
+func (s *RunnersService) DisableProjectRunner(ctx context.Context, pid string, rid int) error {
+	apiEndpoint := fmt.Sprintf("projects/%s/runners/%d", pid, rid)
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodDelete, apiEndpoint, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RunnerAuthenticationToken represents a runner authentication token, the
+// glrt- token a runner manager registers with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+type RunnerAuthenticationToken struct {
+	ID             int        `json:"id"`
+	Token          string     `json:"token"`
+	TokenExpiresAt *time.Time `json:"token_expires_at"`
+}
+
+// CreateUserRunnerOptions represents the available CreateUserRunner()
+// options. GroupID is required with GroupRunnerType and ProjectID with
+// ProjectRunnerType.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+type CreateUserRunnerOptions struct {
+	RunnerType      *RunnerTypeValue `json:"runner_type,omitempty"`
+	GroupID         *int             `json:"group_id,omitempty"`
+	ProjectID       *int             `json:"project_id,omitempty"`
+	Description     *string          `json:"description,omitempty"`
+	Paused          *bool            `json:"paused,omitempty"`
+	Locked          *bool            `json:"locked,omitempty"`
+	RunUntagged     *bool            `json:"run_untagged,omitempty"`
+	TagList         *[]string        `json:"tag_list,omitempty"`
+	AccessLevel     *string          `json:"access_level,omitempty"`
+	MaximumTimeout  *int             `json:"maximum_timeout,omitempty"`
+	MaintenanceNote *string          `json:"maintenance_note,omitempty"`
+}
+
+// CreateUserRunner creates a runner linked to the current user and returns
+// its authentication token. This replaces registration tokens: the token is
+// passed to gitlab-runner register with --token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner-linked-to-a-user
+func (s *RunnersService) CreateUserRunner(ctx context.Context, opts *CreateUserRunnerOptions) (*RunnerAuthenticationToken, error) {
+	var v RunnerAuthenticationToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, "user/runners", opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// VerifyRunnerOptions represents the available VerifyRunner() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#verify-authentication-for-a-registered-runner
+type VerifyRunnerOptions struct {
+	Token    *string `json:"token,omitempty"`
+	SystemID *string `json:"system_id,omitempty"`
+}
+
+// VerifyRunner checks that a runner authentication token is valid. It
+// fails with 403 Forbidden otherwise.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#verify-authentication-for-a-registered-runner
+func (s *RunnersService) VerifyRunner(ctx context.Context, opts *VerifyRunnerOptions) (*RunnerAuthenticationToken, error) {
+	var v RunnerAuthenticationToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, "runners/verify", opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ResetRunnerAuthenticationToken resets the authentication token of a
+// runner by id and returns the new one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-runners-authentication-token-by-using-the-runner-id
+func (s *RunnersService) ResetRunnerAuthenticationToken(ctx context.Context, rid int) (*RunnerAuthenticationToken, error) {
+	apiEndpoint := fmt.Sprintf("runners/%d/reset_authentication_token", rid)
+	var v RunnerAuthenticationToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ResetRunnerAuthenticationTokenByToken resets a runner authentication
+// token using the current token and returns the new one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-runners-authentication-token-by-using-the-current-token
+func (s *RunnersService) ResetRunnerAuthenticationTokenByToken(ctx context.Context, token string) (*RunnerAuthenticationToken, error) {
+	var v RunnerAuthenticationToken
+	if _, err := s.client.InvokeWithCredential(ctx, http.MethodPost, "runners/reset_authentication_token", map[string]string{"token": token}, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
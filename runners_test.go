@@ -0,0 +1,24 @@
+package gitlab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nexuer/go-gitlab"
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestRunnersService_ListRunners(t *testing.T) {
+	client := gitlab.NewClient(testTokenCredential, &gitlab.Options{Debug: true})
+
+	runners, err := client.Runners.ListRunners(context.Background(), &gitlab.ListRunnersOptions{
+		Status:  ptr.Ptr(gitlab.RunnerOnline),
+		TagList: ptr.Ptr([]string{"docker"}),
+	})
+	if err != nil {
+		t.Fatalf("Runners.ListRunners returned error: %v", err)
+	}
+	for _, runner := range runners.Records {
+		t.Logf("runner: %d %s %s\n", runner.ID, runner.Description, runner.Status)
+	}
+}
@@ -1,5 +1,15 @@
 package gitlab
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // TimeStats represents the time estimates and time spent for an issue.
 //
 // GitLab docs: https://docs.gitlab.com/ee/workflow/time_tracking.html
@@ -9,3 +19,215 @@ type TimeStats struct {
 	TimeEstimate        int    `json:"time_estimate"`
 	TotalTimeSpent      int    `json:"total_time_spent"`
 }
+
+// The units of human durations, with GitLab's default conventions: a day
+// is 8 hours of work, a week 5 days and a month 4 weeks.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/user/project/time_tracking.html#available-time-units
+const (
+	humanDay   = 8 * time.Hour
+	humanWeek  = 5 * humanDay
+	humanMonth = 4 * humanWeek
+)
+
+var humanUnits = []struct {
+	name string
+	d    time.Duration
+}{
+	{"mo", humanMonth},
+	{"w", humanWeek},
+	{"d", humanDay},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+}
+
+// ErrInvalidHumanDuration is returned by ParseHumanDuration for malformed
+// durations.
+var ErrInvalidHumanDuration = errors.New("gitlab: invalid human duration")
+
+// ParseHumanDuration parses a duration written the way GitLab time tracking
+// does, such as "1w 2d 3h 30m" or "-1h", with units mo, w, d, h, m and s.
+// Days are 8 hours, weeks 5 days and months 4 weeks.
+func ParseHumanDuration(s string) (time.Duration, error) {
+	str := strings.TrimSpace(s)
+	neg := strings.HasPrefix(str, "-")
+	str = strings.TrimSpace(strings.TrimPrefix(str, "-"))
+	if str == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidHumanDuration, s)
+	}
+
+	var total time.Duration
+	for str != "" {
+		i := 0
+		for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+			i++
+		}
+		n, err := strconv.Atoi(str[:i])
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidHumanDuration, s)
+		}
+		str = str[i:]
+
+		unit := time.Duration(0)
+		for _, u := range humanUnits {
+			if strings.HasPrefix(str, u.name) {
+				unit, str = u.d, str[len(u.name):]
+				break
+			}
+		}
+		if unit == 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidHumanDuration, s)
+		}
+		total += time.Duration(n) * unit
+		str = strings.TrimLeft(str, " ")
+	}
+	if neg {
+		total = -total
+	}
+	return total, nil
+}
+
+// FormatHumanDuration formats d the way GitLab time tracking does, such as
+// "1w 2d 3h 30m", using the largest units first. It is the inverse of
+// ParseHumanDuration; fractions of a second are dropped and the zero
+// duration is "0m".
+func FormatHumanDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	var parts []string
+	for _, u := range humanUnits {
+		if n := d / u.d; n > 0 {
+			parts = append(parts, strconv.FormatInt(int64(n), 10)+u.name)
+			d -= n * u.d
+		}
+	}
+	if len(parts) == 0 {
+		return "0m"
+	}
+	return sign + strings.Join(parts, " ")
+}
+
+// SetTimeEstimateOptions represents the available SetTimeEstimate() options.
+// Duration is a human duration, see FormatHumanDuration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#set-a-time-estimate-for-an-issue
+type SetTimeEstimateOptions struct {
+	Duration *string `json:"duration,omitempty"`
+}
+
+// AddSpentTimeOptions represents the available AddSpentTime() options.
+// Duration is a human duration, see FormatHumanDuration; a negative one
+// subtracts time.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#add-spent-time-for-an-issue
+type AddSpentTimeOptions struct {
+	Duration *string    `json:"duration,omitempty"`
+	Summary  *string    `json:"summary,omitempty"`
+	SpentAt  *time.Time `json:"spent_at,omitempty"`
+}
+
+// SetTimeEstimate sets the time estimate of an issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#set-a-time-estimate-for-an-issue
+func (s *IssuesService) SetTimeEstimate(ctx context.Context, pid string, iid int, opts *SetTimeEstimateOptions) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "issues", pid, iid, "time_estimate", opts)
+}
+
+// ResetTimeEstimate resets the time estimate of an issue to 0.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#reset-the-time-estimate-for-an-issue
+func (s *IssuesService) ResetTimeEstimate(ctx context.Context, pid string, iid int) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "issues", pid, iid, "reset_time_estimate", nil)
+}
+
+// AddSpentTime adds spent time to an issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#add-spent-time-for-an-issue
+func (s *IssuesService) AddSpentTime(ctx context.Context, pid string, iid int, opts *AddSpentTimeOptions) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "issues", pid, iid, "add_spent_time", opts)
+}
+
+// ResetSpentTime resets the total spent time of an issue to 0.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#reset-spent-time-for-an-issue
+func (s *IssuesService) ResetSpentTime(ctx context.Context, pid string, iid int) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "issues", pid, iid, "reset_spent_time", nil)
+}
+
+// GetTimeStats gets the time tracking stats of an issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html#get-time-tracking-stats
+func (s *IssuesService) GetTimeStats(ctx context.Context, pid string, iid int) (*TimeStats, error) {
+	return getTimeStats(ctx, s.client, "issues", pid, iid)
+}
+
+// SetTimeEstimate sets the time estimate of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#set-a-time-estimate-for-a-merge-request
+func (s *MergeRequestsService) SetTimeEstimate(ctx context.Context, pid string, iid int, opts *SetTimeEstimateOptions) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "merge_requests", pid, iid, "time_estimate", opts)
+}
+
+// ResetTimeEstimate resets the time estimate of a merge request to 0.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#reset-the-time-estimate-for-a-merge-request
+func (s *MergeRequestsService) ResetTimeEstimate(ctx context.Context, pid string, iid int) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "merge_requests", pid, iid, "reset_time_estimate", nil)
+}
+
+// AddSpentTime adds spent time to a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#add-spent-time-for-a-merge-request
+func (s *MergeRequestsService) AddSpentTime(ctx context.Context, pid string, iid int, opts *AddSpentTimeOptions) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "merge_requests", pid, iid, "add_spent_time", opts)
+}
+
+// ResetSpentTime resets the total spent time of a merge request to 0.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#reset-spent-time-for-a-merge-request
+func (s *MergeRequestsService) ResetSpentTime(ctx context.Context, pid string, iid int) (*TimeStats, error) {
+	return updateTimeStats(ctx, s.client, "merge_requests", pid, iid, "reset_spent_time", nil)
+}
+
+// GetTimeStats gets the time tracking stats of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#get-time-tracking-stats
+func (s *MergeRequestsService) GetTimeStats(ctx context.Context, pid string, iid int) (*TimeStats, error) {
+	return getTimeStats(ctx, s.client, "merge_requests", pid, iid)
+}
+
+// updateTimeStats posts a time tracking action of an issue or merge request,
+// given by entity.
+func updateTimeStats(ctx context.Context, c *Client, entity, pid string, iid int, action string, opts any) (*TimeStats, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/%s/%d/%s", pid, entity, iid, action)
+	var v TimeStats
+	if _, err := c.InvokeWithCredential(ctx, http.MethodPost, apiEndpoint, opts, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func getTimeStats(ctx context.Context, c *Client, entity, pid string, iid int) (*TimeStats, error) {
+	apiEndpoint := fmt.Sprintf("projects/%s/%s/%d/time_stats", pid, entity, iid)
+	var v TimeStats
+	if _, err := c.InvokeWithCredential(ctx, http.MethodGet, apiEndpoint, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nexuer/utils/ptr"
+)
+
+func TestParseHumanDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"30m":          30 * time.Minute,
+		"1w 2d 3h 30m": (5*8+2*8+3)*time.Hour + 30*time.Minute,
+		"1mo":          4 * 5 * 8 * time.Hour,
+		"1d1h":         9 * time.Hour,
+		"-1h 15m":      -(time.Hour + 15*time.Minute),
+		" 2h  10s ":    2*time.Hour + 10*time.Second,
+		"1mo 1m":       160*time.Hour + time.Minute,
+	}
+	for in, want := range tests {
+		got, err := ParseHumanDuration(in)
+		if err != nil {
+			t.Errorf("%q: %v", in, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: got %s, want %s", in, got, want)
+		}
+	}
+
+	for _, in := range []string{"", "-", "1", "h", "1y", "1h x"} {
+		if _, err := ParseHumanDuration(in); !errors.Is(err, ErrInvalidHumanDuration) {
+			t.Errorf("%q: got error %v, want ErrInvalidHumanDuration", in, err)
+		}
+	}
+}
+
+func TestFormatHumanDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                             "0m",
+		30 * time.Minute:              "30m",
+		59 * time.Hour:                "1w 2d 3h",
+		161*time.Hour + time.Second:   "1mo 1h 1s",
+		-(time.Hour + 15*time.Minute): "-1h 15m",
+	}
+	for in, want := range tests {
+		got := FormatHumanDuration(in)
+		if got != want {
+			t.Errorf("%s: got %q, want %q", in, got, want)
+		}
+		if back, err := ParseHumanDuration(got); err != nil || back != in {
+			t.Errorf("%q: round trip gave %s, %v", got, back, err)
+		}
+	}
+}
+
+func TestMergeRequestsService_AddSpentTime(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/1/merge_requests/2/add_spent_time" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body AddSpentTimeOptions
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Duration == nil || *body.Duration != "1h 30m" || body.Summary == nil {
+			t.Errorf("unexpected body: %+v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"total_time_spent":5400,"human_total_time_spent":"1h 30m"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(&TokenCredential{Endpoint: srv.URL, AccessToken: "token"})
+
+	stats, err := client.MergeRequests.AddSpentTime(context.Background(), "1", 2, &AddSpentTimeOptions{
+		Duration: ptr.Ptr(FormatHumanDuration(90 * time.Minute)),
+		Summary:  ptr.Ptr("review"),
+	})
+	if err != nil {
+		t.Fatalf("MergeRequests.AddSpentTime returned error: %v", err)
+	}
+	if stats.TotalTimeSpent != 5400 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
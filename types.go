@@ -114,8 +114,20 @@ const (
 	Skipped            BuildStateValue = "skipped"
 	Manual             BuildStateValue = "manual"
 	Scheduled          BuildStateValue = "scheduled"
+	Canceling          BuildStateValue = "canceling"
 )
 
+// IsFinished reports whether the state is terminal: success, failed,
+// canceled or skipped. Manual and scheduled jobs are still waiting.
+func (s BuildStateValue) IsFinished() bool {
+	switch s {
+	case Success, Failed, Canceled, Skipped:
+		return true
+	default:
+		return false
+	}
+}
+
 // Labels is a custom type with specific marshaling characteristics.
 type Labels []string
 
@@ -242,3 +254,151 @@ const (
 	UserNamespaceKind  = "user"
 	GroupNamespaceKind = "group"
 )
+
+// VariableTypeValue represents a variable type within GitLab.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_level_variables.html
+type VariableTypeValue string
+
+// List of available variable types.
+const (
+	EnvVariableType  VariableTypeValue = "env_var"
+	FileVariableType VariableTypeValue = "file"
+)
+
+// RunnerTypeValue represents the scope of a runner.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/runners.html
+type RunnerTypeValue string
+
+// List of available runner types.
+const (
+	InstanceRunnerType RunnerTypeValue = "instance_type"
+	GroupRunnerType    RunnerTypeValue = "group_type"
+	ProjectRunnerType  RunnerTypeValue = "project_type"
+)
+
+// RunnerStatusValue represents the connection status of a runner.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/runners.html
+type RunnerStatusValue string
+
+// List of available runner statuses.
+const (
+	RunnerOnline         RunnerStatusValue = "online"
+	RunnerOffline        RunnerStatusValue = "offline"
+	RunnerStale          RunnerStatusValue = "stale"
+	RunnerNeverContacted RunnerStatusValue = "never_contacted"
+)
+
+// DeploymentStatusValue represents the status of a deployment. Deployments
+// share the states of the job that runs them, plus blocked, for deployments
+// waiting for approval.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/deployments.html
+type DeploymentStatusValue string
+
+// List of available deployment statuses.
+const (
+	DeploymentCreated  = DeploymentStatusValue(Created)
+	DeploymentRunning  = DeploymentStatusValue(Running)
+	DeploymentSuccess  = DeploymentStatusValue(Success)
+	DeploymentFailed   = DeploymentStatusValue(Failed)
+	DeploymentCanceled = DeploymentStatusValue(Canceled)
+	DeploymentSkipped  = DeploymentStatusValue(Skipped)
+	DeploymentBlocked  = DeploymentStatusValue("blocked")
+)
+
+// IsFinished reports whether the deployment is in a terminal state, as
+// BuildStateValue.IsFinished does for its job.
+func (s DeploymentStatusValue) IsFinished() bool {
+	return BuildStateValue(s).IsFinished()
+}
+
+// StateEventValue represents a state event of an issue or merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+type StateEventValue string
+
+// List of available state events.
+const (
+	CloseStateEvent  StateEventValue = "close"
+	ReopenStateEvent StateEventValue = "reopen"
+)
+
+// DetailedMergeStatusValue represents the detailed merge status of a merge
+// request, telling why it can or cannot be merged.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+type DetailedMergeStatusValue string
+
+// List of available detailed merge statuses.
+const (
+	ApprovalsSyncingMergeStatus         DetailedMergeStatusValue = "approvals_syncing"
+	CheckingMergeStatus                 DetailedMergeStatusValue = "checking"
+	CIMustPassMergeStatus               DetailedMergeStatusValue = "ci_must_pass"
+	CIStillRunningMergeStatus           DetailedMergeStatusValue = "ci_still_running"
+	CommitsStatusMergeStatus            DetailedMergeStatusValue = "commits_status"
+	ConflictMergeStatus                 DetailedMergeStatusValue = "conflict"
+	DiscussionsNotResolvedMergeStatus   DetailedMergeStatusValue = "discussions_not_resolved"
+	DraftStatusMergeStatus              DetailedMergeStatusValue = "draft_status"
+	JiraAssociationMissingMergeStatus   DetailedMergeStatusValue = "jira_association_missing"
+	LockedLFSFilesMergeStatus           DetailedMergeStatusValue = "locked_lfs_files"
+	LockedPathsMergeStatus              DetailedMergeStatusValue = "locked_paths"
+	MergeableMergeStatus                DetailedMergeStatusValue = "mergeable"
+	MergeRequestBlockedMergeStatus      DetailedMergeStatusValue = "merge_request_blocked"
+	MergeTimeMergeStatus                DetailedMergeStatusValue = "merge_time"
+	NeedRebaseMergeStatus               DetailedMergeStatusValue = "need_rebase"
+	NotApprovedMergeStatus              DetailedMergeStatusValue = "not_approved"
+	NotOpenMergeStatus                  DetailedMergeStatusValue = "not_open"
+	PreparingMergeStatus                DetailedMergeStatusValue = "preparing"
+	RequestedChangesMergeStatus         DetailedMergeStatusValue = "requested_changes"
+	SecurityPolicyViolationsMergeStatus DetailedMergeStatusValue = "security_policy_violations"
+	StatusChecksMustPassMergeStatus     DetailedMergeStatusValue = "status_checks_must_pass"
+	TitleRegexMergeStatus               DetailedMergeStatusValue = "title_regex"
+	UncheckedMergeStatus                DetailedMergeStatusValue = "unchecked"
+)
+
+// MergeabilityValue classifies a DetailedMergeStatusValue.
+type MergeabilityValue int
+
+const (
+	// MergeabilityBlocked means the merge request cannot be merged until
+	// someone acts on it, for example by resolving conflicts, rebasing or
+	// marking it ready.
+	MergeabilityBlocked MergeabilityValue = iota
+	// MergeabilityPending means the merge request may become mergeable on
+	// its own: GitLab is still checking it, or it waits for approvals,
+	// pipelines, status checks or its merge time.
+	MergeabilityPending
+	// MergeabilityReady means the merge request can be merged now.
+	MergeabilityReady
+)
+
+func (m MergeabilityValue) String() string {
+	switch m {
+	case MergeabilityReady:
+		return "Ready"
+	case MergeabilityPending:
+		return "Pending"
+	default:
+		return "Blocked"
+	}
+}
+
+// Mergeable classifies the status. Unknown statuses are blocked, so new
+// checks added by GitLab are never merged through by accident.
+func (s DetailedMergeStatusValue) Mergeable() MergeabilityValue {
+	switch s {
+	case MergeableMergeStatus:
+		return MergeabilityReady
+	case ApprovalsSyncingMergeStatus, CheckingMergeStatus, UncheckedMergeStatus, PreparingMergeStatus,
+		CIMustPassMergeStatus, CIStillRunningMergeStatus, NotApprovedMergeStatus,
+		StatusChecksMustPassMergeStatus, MergeTimeMergeStatus:
+		return MergeabilityPending
+	default:
+		return MergeabilityBlocked
+	}
+}